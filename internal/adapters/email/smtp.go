@@ -0,0 +1,181 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bakhtybayevn/powerbook/internal/domain/email"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// SMTPSender delivers mail through an SMTP server. STARTTLS is used when
+// the server offers it, and credentials are only sent when configured, so a
+// local MailHog-style server works without either.
+type SMTPSender struct {
+	addr     string
+	host     string
+	username string
+	password string
+	from     mail.Address
+	timeout  time.Duration
+}
+
+func NewSMTPSender(host string, port int, username, password, from string) (*SMTPSender, error) {
+	addr, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, fmt.Errorf("smtp: invalid from address %q: %w", from, err)
+	}
+	if port == 0 {
+		port = 587
+	}
+	return &SMTPSender{
+		addr:     net.JoinHostPort(host, strconv.Itoa(port)),
+		host:     host,
+		username: username,
+		password: password,
+		from:     *addr,
+		timeout:  30 * time.Second,
+	}, nil
+}
+
+func (s *SMTPSender) Send(ctx context.Context, m email.Message) error {
+	to, err := mail.ParseAddress(m.To)
+	if err != nil {
+		return fmt.Errorf("%w: invalid recipient %q", ports.ErrEmailRejected, m.To)
+	}
+
+	body, err := s.compose(to, m)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", s.addr)
+	if err != nil {
+		return fmt.Errorf("smtp: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	c, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		conn.Close()
+		return classify(err)
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: s.host}); err != nil {
+			return classify(err)
+		}
+	}
+	if s.username != "" {
+		if err := c.Auth(smtp.PlainAuth("", s.username, s.password, s.host)); err != nil {
+			return classify(err)
+		}
+	}
+
+	if err := c.Mail(s.from.Address); err != nil {
+		return classify(err)
+	}
+	if err := c.Rcpt(to.Address); err != nil {
+		return classify(err)
+	}
+	w, err := c.Data()
+	if err != nil {
+		return classify(err)
+	}
+	if _, err := w.Write(body); err != nil {
+		return classify(err)
+	}
+	if err := w.Close(); err != nil {
+		return classify(err)
+	}
+	// the server accepted the message once DATA was closed; failing now
+	// would have the outbox send it again
+	if err := c.Quit(); err != nil {
+		log.Printf("[SMTPSender] QUIT after delivery failed: %v", err)
+	}
+	return nil
+}
+
+// compose builds a multipart/alternative message with the text part first,
+// so clients that can show HTML pick the last part.
+func (s *SMTPSender) compose(to *mail.Address, m email.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+
+	header := func(k, v string) { fmt.Fprintf(&buf, "%s: %s\r\n", k, v) }
+	header("From", s.from.String())
+	header("To", to.String())
+	header("Subject", mime.QEncoding.Encode("utf-8", m.Subject))
+	header("Date", time.Now().Format(time.RFC1123Z))
+	header("Message-ID", s.messageID())
+	header("MIME-Version", "1.0")
+	header("Content-Type", "multipart/alternative; boundary="+mw.Boundary())
+	buf.WriteString("\r\n")
+
+	parts := []struct{ contentType, content string }{{"text/plain", m.Text}}
+	if m.HTML != "" {
+		parts = append(parts, struct{ contentType, content string }{"text/html", m.HTML})
+	}
+	for _, p := range parts {
+		pw, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {p.contentType + "; charset=utf-8"},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		qp := quotedprintable.NewWriter(pw)
+		if _, err := qp.Write([]byte(p.content)); err != nil {
+			return nil, err
+		}
+		if err := qp.Close(); err != nil {
+			return nil, err
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (s *SMTPSender) messageID() string {
+	b := make([]byte, 12)
+	_, _ = rand.Read(b)
+	domain := s.from.Address[strings.LastIndex(s.from.Address, "@")+1:]
+	return "<" + hex.EncodeToString(b) + "@" + domain + ">"
+}
+
+// classify marks permanent (5xx) replies as rejected; everything else,
+// including 4xx replies and network errors, is worth retrying.
+func classify(err error) error {
+	if err == nil {
+		return nil
+	}
+	var reply *textproto.Error
+	if errors.As(err, &reply) && reply.Code >= 500 {
+		return fmt.Errorf("%w: %v", ports.ErrEmailRejected, err)
+	}
+	return fmt.Errorf("smtp: %w", err)
+}
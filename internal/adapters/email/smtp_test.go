@@ -0,0 +1,207 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+
+	"github.com/bakhtybayevn/powerbook/internal/domain/email"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// smtpStub is a minimal SMTP server. replies overrides the answer to a
+// command ("MAIL", "RCPT", "QUIT", or "." for the end of the message); an
+// empty override hangs up instead of answering.
+type smtpStub struct {
+	ln       net.Listener
+	replies  map[string]string
+	messages chan string
+}
+
+func newSMTPStub(t *testing.T, replies map[string]string) *smtpStub {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	s := &smtpStub{ln: ln, replies: replies, messages: make(chan string, 1)}
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *smtpStub) serve(conn net.Conn) {
+	defer conn.Close()
+	tc := textproto.NewConn(conn)
+
+	// answer writes the override for cmd, or def; false means hang up
+	answer := func(cmd, def string) bool {
+		reply, ok := s.replies[cmd]
+		if !ok {
+			reply = def
+		}
+		if reply == "" {
+			return false
+		}
+		return tc.PrintfLine("%s", reply) == nil
+	}
+
+	if !answer("GREETING", "220 stub ESMTP") {
+		return
+	}
+	for {
+		line, err := tc.ReadLine()
+		if err != nil {
+			return
+		}
+		cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch cmd {
+		case "EHLO", "HELO":
+			if !answer(cmd, "250 stub") {
+				return
+			}
+		case "MAIL", "RCPT", "RSET", "NOOP":
+			if !answer(cmd, "250 OK") {
+				return
+			}
+		case "DATA":
+			if !answer("DATA", "354 go ahead") {
+				return
+			}
+			body, err := tc.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			if !answer(".", "250 queued") {
+				return
+			}
+			if reply, ok := s.replies["."]; !ok || strings.HasPrefix(reply, "2") {
+				s.messages <- string(body)
+			}
+		case "QUIT":
+			answer("QUIT", "221 bye")
+			return
+		default:
+			if !answer(cmd, "502 not implemented") {
+				return
+			}
+		}
+	}
+}
+
+func (s *smtpStub) sender(t *testing.T) *SMTPSender {
+	t.Helper()
+
+	addr := s.ln.Addr().(*net.TCPAddr)
+	sender, err := NewSMTPSender("127.0.0.1", addr.Port, "", "", "PowerBook <noreply@powerbook.test>")
+	if err != nil {
+		t.Fatalf("new sender: %v", err)
+	}
+	return sender
+}
+
+func TestSMTPSenderSend(t *testing.T) {
+	msg := email.Message{To: "reader@example.com", Subject: "Hello", Text: "Plain body", HTML: "<p>HTML body</p>"}
+
+	tests := []struct {
+		name         string
+		replies      map[string]string
+		msg          email.Message
+		wantErr      bool
+		wantRejected bool
+		wantSent     bool
+	}{
+		{
+			name:     "delivered",
+			msg:      msg,
+			wantSent: true,
+		},
+		{
+			name:         "recipient rejected",
+			replies:      map[string]string{"RCPT": "550 no such user"},
+			msg:          msg,
+			wantErr:      true,
+			wantRejected: true,
+		},
+		{
+			name:    "recipient deferred",
+			replies: map[string]string{"RCPT": "451 try again later"},
+			msg:     msg,
+			wantErr: true,
+		},
+		{
+			name:    "sender deferred",
+			replies: map[string]string{"MAIL": "421 too busy"},
+			msg:     msg,
+			wantErr: true,
+		},
+		{
+			name:         "message rejected after data",
+			replies:      map[string]string{".": "554 spam"},
+			msg:          msg,
+			wantErr:      true,
+			wantRejected: true,
+		},
+		{
+			name:     "quit fails after the message was accepted",
+			replies:  map[string]string{"QUIT": "421 closing"},
+			msg:      msg,
+			wantSent: true,
+		},
+		{
+			name:     "connection dropped on quit",
+			replies:  map[string]string{"QUIT": ""},
+			msg:      msg,
+			wantSent: true,
+		},
+		{
+			name:         "invalid recipient address",
+			msg:          email.Message{To: "not an address", Subject: "Hello", Text: "Plain body"},
+			wantErr:      true,
+			wantRejected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := newSMTPStub(t, tt.replies)
+
+			err := stub.sender(t).Send(context.Background(), tt.msg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Send() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := errors.Is(err, ports.ErrEmailRejected); got != tt.wantRejected {
+				t.Errorf("rejected = %v, want %v (err: %v)", got, tt.wantRejected, err)
+			}
+
+			select {
+			case body := <-stub.messages:
+				if !tt.wantSent {
+					t.Fatalf("message was delivered, want none")
+				}
+				for _, want := range []string{"Subject: Hello", "To: <reader@example.com>", "Plain body", "<p>HTML body</p>"} {
+					if !strings.Contains(body, want) {
+						t.Errorf("message is missing %q:\n%s", want, body)
+					}
+				}
+			default:
+				if tt.wantSent {
+					t.Fatalf("no message was delivered")
+				}
+			}
+		})
+	}
+}
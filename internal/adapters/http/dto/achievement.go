@@ -0,0 +1,47 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/bakhtybayevn/powerbook/internal/domain/achievement"
+)
+
+type AchievementDTO struct {
+	ID          string `json:"id" example:"streak_7"`
+	Name        string `json:"name" example:"Week Streak"`
+	Description string `json:"description" example:"Read 7 days in a row"`
+	XPReward    int    `json:"xp_reward" example:"25"`
+	UnlockedAt  string `json:"unlocked_at,omitempty"`
+}
+
+func achievementToDTO(d achievement.Definition) AchievementDTO {
+	return AchievementDTO{
+		ID:          d.ID,
+		Name:        d.Name,
+		Description: d.Description,
+		XPReward:    d.XPReward,
+	}
+}
+
+func AchievementsToDTO(defs []achievement.Definition) []AchievementDTO {
+	out := make([]AchievementDTO, 0, len(defs))
+	for _, d := range defs {
+		out = append(out, achievementToDTO(d))
+	}
+	return out
+}
+
+// BadgesToDTO describes a user's unlocks; ids no longer in the catalog are skipped.
+func BadgesToDTO(unlocks []*achievement.Unlock) []AchievementDTO {
+	out := make([]AchievementDTO, 0, len(unlocks))
+	for _, u := range unlocks {
+		d, ok := achievement.Find(u.AchievementID)
+		if !ok {
+			continue
+		}
+		b := achievementToDTO(d)
+		b.UnlockedAt = u.UnlockedAt.Format(time.RFC3339)
+		out = append(out, b)
+	}
+	return out
+}
@@ -8,3 +8,17 @@ type LoginRequest struct {
 type LoginResponse struct {
 	Token string `json:"token"`
 }
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" example:"123456"`
+	NewPassword     string `json:"new_password" example:"s3cret-new"`
+}
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email" example:"test@example.com"`
+}
+
+type ResetPasswordRequest struct {
+	Token       string `json:"token"`
+	NewPassword string `json:"new_password" example:"s3cret-new"`
+}
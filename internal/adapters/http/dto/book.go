@@ -0,0 +1,39 @@
+package dto
+
+import "github.com/bakhtybayevn/powerbook/internal/domain/book"
+
+type AddBookRequest struct {
+	Title     string `json:"title" example:"The Pragmatic Programmer"`
+	Author    string `json:"author" example:"David Thomas, Andrew Hunt"`
+	ISBN      string `json:"isbn" example:"978-0135957059"`
+	PageCount int    `json:"page_count" example:"352"`
+	CoverURL  string `json:"cover_url" example:"https://covers.example.com/pragprog.jpg"`
+}
+
+type BookDTO struct {
+	ID        string `json:"id"`
+	Title     string `json:"title"`
+	Author    string `json:"author"`
+	ISBN      string `json:"isbn"`
+	PageCount int    `json:"page_count"`
+	CoverURL  string `json:"cover_url"`
+}
+
+func BookToDTO(b *book.Book) BookDTO {
+	return BookDTO{
+		ID:        b.ID,
+		Title:     b.Title,
+		Author:    b.Author,
+		ISBN:      b.ISBN,
+		PageCount: b.PageCount,
+		CoverURL:  b.CoverURL,
+	}
+}
+
+func BooksToDTO(list []*book.Book) []BookDTO {
+	out := make([]BookDTO, 0, len(list))
+	for _, b := range list {
+		out = append(out, BookToDTO(b))
+	}
+	return out
+}
@@ -9,25 +9,87 @@ import (
 
 // Request to create competition
 type CreateCompetitionRequest struct {
-	Name            string    `json:"name" example:"January Reading Contest"`
-	StartDate       time.Time `json:"start_date" example:"2025-01-01T00:00:00Z"`
-	EndDate         time.Time `json:"end_date" example:"2025-01-31T23:59:59Z"`
-	PointsPerMinute int       `json:"points_per_minute" example:"1"`
+	Name             string    `json:"name" example:"January Reading Contest"`
+	StartDate        time.Time `json:"start_date" example:"2025-01-01T00:00:00Z"`
+	EndDate          time.Time `json:"end_date" example:"2025-01-31T23:59:59Z"`
+	PointsPerMinute  int       `json:"points_per_minute" example:"1"`
+	ScoringType      string    `json:"scoring_type,omitempty" example:"minutes"` // minutes, capped_minutes, days_read, pages_read, books_finished, longest_streak
+	PointsPerUnit    int       `json:"points_per_unit,omitempty" example:"10"`
+	DailyMinuteCap   int       `json:"daily_minute_cap,omitempty" example:"120"`
+	Format           string    `json:"format,omitempty" example:"individual"` // individual, team
+	Visibility       string    `json:"visibility,omitempty" example:"public"` // public (unlocked at level 3), unlisted, private; omitted = public once unlocked, else unlisted
+	RequiresApproval bool      `json:"requires_approval,omitempty" example:"false"`
+	MaxParticipants  int       `json:"max_participants,omitempty" example:"20"` // 0 = unlimited
+}
+
+// Partial update; omitted fields stay unchanged. Rules and start date
+// can only change before the competition starts.
+type UpdateCompetitionRequest struct {
+	Name             *string    `json:"name,omitempty" example:"February Reading Contest"`
+	StartDate        *time.Time `json:"start_date,omitempty" example:"2025-02-01T00:00:00Z"`
+	EndDate          *time.Time `json:"end_date,omitempty" example:"2025-02-28T23:59:59Z"`
+	PointsPerMinute  *int       `json:"points_per_minute,omitempty" example:"1"`
+	ScoringType      *string    `json:"scoring_type,omitempty" example:"minutes"`
+	PointsPerUnit    *int       `json:"points_per_unit,omitempty" example:"10"`
+	DailyMinuteCap   *int       `json:"daily_minute_cap,omitempty" example:"120"`
+	Visibility       *string    `json:"visibility,omitempty" example:"public"`
+	RequiresApproval *bool      `json:"requires_approval,omitempty" example:"false"`
+	MaxParticipants  *int       `json:"max_participants,omitempty" example:"20"`
 }
 
 // Response for created competition
 type CompetitionResponse struct {
-	ID              string    `json:"id"`
-	Name            string    `json:"name"`
-	StartDate       time.Time `json:"start_date"`
-	EndDate         time.Time `json:"end_date"`
-	Status          string    `json:"status"`
-	PointsPerMinute int       `json:"points_per_minute"`
+	ID               string    `json:"id"`
+	Name             string    `json:"name"`
+	StartDate        time.Time `json:"start_date"`
+	EndDate          time.Time `json:"end_date"`
+	Status           string    `json:"status"`
+	PointsPerMinute  int       `json:"points_per_minute"`
+	ScoringType      string    `json:"scoring_type"`
+	PointsPerUnit    int       `json:"points_per_unit"`
+	DailyMinuteCap   int       `json:"daily_minute_cap"`
+	Format           string    `json:"format"`
+	OwnerID          string    `json:"owner_id"`
+	Visibility       string    `json:"visibility"`
+	InviteCode       string    `json:"invite_code,omitempty"`
+	RequiresApproval bool      `json:"requires_approval"`
+	MaxParticipants  int       `json:"max_participants"`
 }
 
 // Join request
 type JoinCompetitionRequest struct {
 	CompetitionID string `json:"competition_id" example:"cmp-123"`
+	TeamID        string `json:"team_id,omitempty" example:"b1f1e0c4-6a5e-4b8e-9d0e-0f4c1a2b3c4d"` // required for team competitions
+	InviteCode    string `json:"invite_code,omitempty" example:"K3QF7ZPA"`                         // required for private competitions
+}
+
+type AddOrganizerRequest struct {
+	UserID string `json:"user_id" example:"b1f1e0c4-6a5e-4b8e-9d0e-0f4c1a2b3c4d"`
+}
+
+type KickParticipantRequest struct {
+	Reason string `json:"reason,omitempty" example:"logged impossible reading times"`
+}
+
+type JoinRequestDTO struct {
+	User      PublicUserDTO `json:"user"`
+	TeamID    string        `json:"team_id,omitempty"`
+	Status    string        `json:"status"`
+	CreatedAt string        `json:"created_at"`
+}
+
+// Create team request
+type CreateTeamRequest struct {
+	Name       string `json:"name" example:"Night Owls"`
+	InviteCode string `json:"invite_code,omitempty" example:"K3QF7ZPA"` // required for private competitions
+}
+
+type TeamDTO struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	CaptainID string `json:"captain_id,omitempty"`
+	Points    int    `json:"points"`
+	Members   int    `json:"members"`
 }
 
 // Close competition response (summary)
@@ -41,21 +103,38 @@ type PublicUserDTO struct {
 }
 
 type ParticipantDTO struct {
-	User         PublicUserDTO `json:"user"`
-	Points       int           `json:"points"`
-	DaysRead     int           `json:"days_read"`
-	MinutesTotal int           `json:"minutes_total"`
-	LastLogDate  *string       `json:"last_log_date,omitempty"`
+	User          PublicUserDTO `json:"user"`
+	TeamID        string        `json:"team_id,omitempty"`
+	Points        int           `json:"points"`
+	DaysRead      int           `json:"days_read"`
+	MinutesTotal  int           `json:"minutes_total"`
+	PagesTotal    int           `json:"pages_total"`
+	BooksFinished int           `json:"books_finished"`
+	StreakLongest int           `json:"streak_longest"`
+	LastLogDate   *string       `json:"last_log_date,omitempty"`
 }
 
 type CompetitionDTO struct {
-	ID           string           `json:"id"`
-	Name         string           `json:"name"`
-	StartDate    string           `json:"start_date"`
-	EndDate      string           `json:"end_date"`
-	Status       string           `json:"status"`
-	Points       int              `json:"points_per_minute"`
-	Participants []ParticipantDTO `json:"participants,omitempty"`
+	ID               string           `json:"id"`
+	Name             string           `json:"name"`
+	StartDate        string           `json:"start_date"`
+	EndDate          string           `json:"end_date"`
+	Status           string           `json:"status"`
+	Points           int              `json:"points_per_minute"`
+	ScoringType      string           `json:"scoring_type"`
+	PointsPerUnit    int              `json:"points_per_unit"`
+	DailyMinuteCap   int              `json:"daily_minute_cap"`
+	Format           string           `json:"format"`
+	OwnerID          string           `json:"owner_id,omitempty"`
+	Organizers       []string         `json:"organizers,omitempty"`
+	SeriesID         string           `json:"series_id,omitempty"`
+	Visibility       string           `json:"visibility"`
+	InviteCode       string           `json:"invite_code,omitempty"` // only shown to organizers
+	RequiresApproval bool             `json:"requires_approval"`
+	MaxParticipants  int              `json:"max_participants"`
+	XPPolicyVersion  int              `json:"xp_policy_version,omitempty"` // set once closed
+	Participants     []ParticipantDTO `json:"participants,omitempty"`
+	Teams            []TeamDTO        `json:"teams,omitempty"`
 }
 
 func UserToPublicDTO(u *user.User) PublicUserDTO {
@@ -79,11 +158,15 @@ func ParticipantToDTO(p *competition.Participant, u *user.User) ParticipantDTO {
 	}
 
 	return ParticipantDTO{
-		User:         UserToPublicDTO(u),
-		Points:       p.Points,
-		DaysRead:     p.DaysRead,
-		MinutesTotal: p.MinutesTotal,
-		LastLogDate:  last,
+		User:          UserToPublicDTO(u),
+		TeamID:        p.TeamID,
+		Points:        p.Points,
+		DaysRead:      p.DaysRead,
+		MinutesTotal:  p.MinutesTotal,
+		PagesTotal:    p.PagesTotal,
+		BooksFinished: p.BooksFinished,
+		StreakLongest: p.StreakLongest,
+		LastLogDate:   last,
 	}
 }
 
@@ -96,16 +179,44 @@ func CompetitionToDTO(c *competition.Competition, users map[string]*user.User) C
 	}
 
 	return CompetitionDTO{
-		ID:           c.ID,
-		Name:         c.Name,
-		StartDate:    c.StartDate.Format(time.RFC3339),
-		EndDate:      c.EndDate.Format(time.RFC3339),
-		Status:       string(c.Status),
-		Points:       c.Rules.PointsPerMinute,
-		Participants: participants,
+		ID:               c.ID,
+		Name:             c.Name,
+		StartDate:        c.StartDate.Format(time.RFC3339),
+		EndDate:          c.EndDate.Format(time.RFC3339),
+		Status:           string(c.Status),
+		Points:           c.Rules.PointsPerMinute,
+		ScoringType:      string(c.Rules.ScoringType()),
+		PointsPerUnit:    c.Rules.PointsPerUnit,
+		DailyMinuteCap:   c.Rules.DailyMinuteCap,
+		Format:           string(c.Format),
+		OwnerID:          c.OwnerID,
+		Organizers:       c.Organizers,
+		SeriesID:         c.SeriesID,
+		Visibility:       string(c.Visibility),
+		RequiresApproval: c.RequiresApproval,
+		MaxParticipants:  c.MaxParticipants,
+		XPPolicyVersion:  c.XPPolicyVersion,
+		Participants:     participants,
+		Teams:            TeamsToDTO(c),
 	}
 }
 
+// TeamsToDTO lists a competition's teams with summed member points, best first.
+func TeamsToDTO(c *competition.Competition) []TeamDTO {
+	standings := c.TeamStandings()
+	out := make([]TeamDTO, 0, len(standings))
+	for _, s := range standings {
+		out = append(out, TeamDTO{
+			ID:        s.Team.ID,
+			Name:      s.Team.Name,
+			CaptainID: s.Team.CaptainID,
+			Points:    s.Points,
+			Members:   len(s.Members),
+		})
+	}
+	return out
+}
+
 func CompetitionsToDTO(list []*competition.Competition, users map[string]*user.User) []CompetitionDTO {
 	out := make([]CompetitionDTO, 0, len(list))
 	for _, c := range list {
@@ -113,3 +224,58 @@ func CompetitionsToDTO(list []*competition.Competition, users map[string]*user.U
 	}
 	return out
 }
+
+func JoinRequestsToDTO(list []*competition.JoinRequest, users map[string]*user.User) []JoinRequestDTO {
+	out := make([]JoinRequestDTO, 0, len(list))
+	for _, jr := range list {
+		u := users[jr.UserID]
+		dto := JoinRequestDTO{
+			User:      UserToPublicDTO(u),
+			TeamID:    jr.TeamID,
+			Status:    string(jr.Status),
+			CreatedAt: jr.CreatedAt.Format(time.RFC3339),
+		}
+		if u == nil {
+			dto.User.ID = jr.UserID
+		}
+		out = append(out, dto)
+	}
+	return out
+}
+
+// ResultDTO is one row of a closed competition's final standings.
+type ResultDTO struct {
+	Rank         int           `json:"rank"`
+	User         PublicUserDTO `json:"user"`
+	Points       int           `json:"points"`
+	DaysRead     int           `json:"days_read"`
+	MinutesTotal int           `json:"minutes_total"`
+	XPEarned     int           `json:"xp_earned"`
+	Group        string        `json:"group"`
+	TeamID       string        `json:"team_id,omitempty"`
+	TeamName     string        `json:"team_name,omitempty"`
+	TeamRank     int           `json:"team_rank,omitempty"`
+}
+
+func ResultsToDTO(rows []competition.Result, users map[string]*user.User) []ResultDTO {
+	out := make([]ResultDTO, 0, len(rows))
+	for _, r := range rows {
+		u := UserToPublicDTO(users[r.UserID])
+		if u.ID == "" {
+			u.ID = r.UserID
+		}
+		out = append(out, ResultDTO{
+			Rank:         r.Rank,
+			User:         u,
+			Points:       r.Points,
+			DaysRead:     r.DaysRead,
+			MinutesTotal: r.MinutesTotal,
+			XPEarned:     r.XPEarned,
+			Group:        r.Group,
+			TeamID:       r.TeamID,
+			TeamName:     r.TeamName,
+			TeamRank:     r.TeamRank,
+		})
+	}
+	return out
+}
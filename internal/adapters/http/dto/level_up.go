@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/bakhtybayevn/powerbook/internal/domain/xp"
+)
+
+type LevelUpDTO struct {
+	UserID    string `json:"user_id"`
+	Level     int    `json:"level" example:"4"`
+	LevelName string `json:"level_name" example:"Scholar"`
+	XP        int    `json:"xp" example:"610"`
+	CreatedAt string `json:"created_at"`
+
+	// what the level unlocked
+	RewardStreakFreezes      int    `json:"reward_streak_freezes,omitempty" example:"1"`
+	RewardFlair              string `json:"reward_flair,omitempty" example:"bookworm"`
+	RewardPublicCompetitions bool   `json:"reward_public_competitions,omitempty"`
+}
+
+func LevelUpsToDTO(list []*xp.LevelUp) []LevelUpDTO {
+	out := make([]LevelUpDTO, 0, len(list))
+	for _, e := range list {
+		out = append(out, LevelUpDTO{
+			UserID:                   e.UserID,
+			Level:                    e.Level,
+			LevelName:                e.LevelName,
+			XP:                       e.XP,
+			CreatedAt:                e.CreatedAt.Format(time.RFC3339),
+			RewardStreakFreezes:      e.Reward.StreakFreezes,
+			RewardFlair:              e.Reward.Flair,
+			RewardPublicCompetitions: e.Reward.PublicCompetitions,
+		})
+	}
+	return out
+}
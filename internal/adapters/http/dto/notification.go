@@ -0,0 +1,43 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/bakhtybayevn/powerbook/internal/domain/notification"
+)
+
+type NotificationDTO struct {
+	ID            string `json:"id"`
+	Kind          string `json:"kind" example:"gift_pairing"`
+	Title         string `json:"title" example:"Gift exchange for Spring Sprint"`
+	Body          string `json:"body" example:"You give a gift to Aigerim."`
+	CompetitionID string `json:"competition_id,omitempty"`
+	CreatedAt     string `json:"created_at"`
+	ReadAt        string `json:"read_at,omitempty"`
+	Read          bool   `json:"read"`
+}
+
+func NotificationsToDTO(list []*notification.Notification) []NotificationDTO {
+	out := make([]NotificationDTO, 0, len(list))
+	for _, n := range list {
+		d := NotificationDTO{
+			ID:            n.ID,
+			Kind:          string(n.Kind),
+			Title:         n.Title,
+			Body:          n.Body,
+			CompetitionID: n.CompetitionID,
+			CreatedAt:     n.CreatedAt.Format(time.RFC3339),
+			Read:          n.IsRead(),
+		}
+		if n.ReadAt != nil {
+			d.ReadAt = n.ReadAt.Format(time.RFC3339)
+		}
+		out = append(out, d)
+	}
+	return out
+}
+
+type NotificationPreferencesDTO struct {
+	Email    bool `json:"email"`
+	Telegram bool `json:"telegram"`
+}
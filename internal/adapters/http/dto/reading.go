@@ -3,12 +3,31 @@ package dto
 import "time"
 
 type LogReadingRequest struct {
-	Minutes   int        `json:"minutes" example:"20"`
-	Source    string     `json:"source" example:"web"` // allowed: web, app, tg
-	Timestamp *time.Time `json:"timestamp,omitempty" swaggertype:"string" example:"2025-11-18T12:34:56Z"`
+	Minutes      int        `json:"minutes" example:"20"`
+	Source       string     `json:"source" example:"web"` // allowed: web, app, tg
+	Timestamp    *time.Time `json:"timestamp,omitempty" swaggertype:"string" example:"2025-11-18T12:34:56Z"`
+	BookID       string     `json:"book_id,omitempty" example:"6f1c2b7e-1d2a-4c55-9a43-2f7d3e1b8c90"`
+	PagesRead    int        `json:"pages_read,omitempty" example:"15"`
+	FinishedBook bool       `json:"finished_book,omitempty" example:"false"`
 }
 
 type LogReadingResponse struct {
 	NewStreak          int `json:"new_streak" example:"3"`
 	TotalMinutesLogged int `json:"total_minutes_logged" example:"320"`
+
+	StreakFreezes      *int `json:"streak_freezes,omitempty" example:"1"`
+	StreakFreezeUsed   bool `json:"streak_freeze_used,omitempty" example:"false"`
+	StreakFreezeEarned bool `json:"streak_freeze_earned,omitempty" example:"false"`
+
+	AchievementsUnlocked []AchievementDTO `json:"achievements_unlocked,omitempty"`
+	LevelUps             []LevelUpDTO     `json:"level_ups,omitempty"`
+}
+
+type UpdateReadingRequest struct {
+	Minutes      int        `json:"minutes" example:"60"`
+	Source       string     `json:"source,omitempty" example:"web"`
+	Timestamp    *time.Time `json:"timestamp,omitempty" swaggertype:"string" example:"2025-11-18T12:34:56Z"`
+	BookID       string     `json:"book_id,omitempty" example:"6f1c2b7e-1d2a-4c55-9a43-2f7d3e1b8c90"`
+	PagesRead    int        `json:"pages_read,omitempty" example:"15"`
+	FinishedBook bool       `json:"finished_book,omitempty" example:"false"`
 }
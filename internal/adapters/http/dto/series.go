@@ -0,0 +1,120 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/bakhtybayevn/powerbook/internal/domain/competition"
+	"github.com/bakhtybayevn/powerbook/internal/domain/user"
+)
+
+type CreateSeriesRequest struct {
+	Name             string    `json:"name" example:"Reading Sprint"`
+	Recurrence       string    `json:"recurrence" example:"monthly"` // weekly, monthly
+	FirstStart       time.Time `json:"first_start" example:"2025-01-01T00:00:00Z"`
+	PointsPerMinute  int       `json:"points_per_minute" example:"1"`
+	ScoringType      string    `json:"scoring_type,omitempty" example:"minutes"`
+	PointsPerUnit    int       `json:"points_per_unit,omitempty" example:"10"`
+	DailyMinuteCap   int       `json:"daily_minute_cap,omitempty" example:"120"`
+	Format           string    `json:"format,omitempty" example:"individual"`
+	Visibility       string    `json:"visibility,omitempty" example:"public"`
+	RequiresApproval bool      `json:"requires_approval,omitempty" example:"false"`
+	MaxParticipants  int       `json:"max_participants,omitempty" example:"0"`
+}
+
+type SeriesDTO struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	OwnerID         string `json:"owner_id,omitempty"`
+	Recurrence      string `json:"recurrence"`
+	ScoringType     string `json:"scoring_type"`
+	PointsPerMinute int    `json:"points_per_minute"`
+	PointsPerUnit   int    `json:"points_per_unit"`
+	DailyMinuteCap  int    `json:"daily_minute_cap"`
+	Format          string `json:"format"`
+	Visibility      string `json:"visibility"`
+	NextStart       string `json:"next_start"`
+	Active          bool   `json:"active"`
+}
+
+type CreateSeasonRequest struct {
+	Name      string    `json:"name" example:"Spring 2025"`
+	StartDate time.Time `json:"start_date" example:"2025-03-01T00:00:00Z"`
+	EndDate   time.Time `json:"end_date" example:"2025-05-31T23:59:59Z"`
+}
+
+type SeasonDTO struct {
+	ID        string `json:"id"`
+	SeriesID  string `json:"series_id"`
+	Name      string `json:"name"`
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+	Status    string `json:"status"`
+
+	XPPolicyVersion int `json:"xp_policy_version,omitempty"` // set once closed
+}
+
+type SeasonStandingDTO struct {
+	Rank         int           `json:"rank"`
+	User         PublicUserDTO `json:"user"`
+	Points       int           `json:"points"`
+	Competitions int           `json:"competitions"`
+	Wins         int           `json:"wins"`
+	XPEarned     int           `json:"xp_earned,omitempty"`
+}
+
+func SeriesToDTO(s *competition.Series) SeriesDTO {
+	return SeriesDTO{
+		ID:              s.ID,
+		Name:            s.Name,
+		OwnerID:         s.OwnerID,
+		Recurrence:      string(s.Recurrence),
+		ScoringType:     string(s.Rules.ScoringType()),
+		PointsPerMinute: s.Rules.PointsPerMinute,
+		PointsPerUnit:   s.Rules.PointsPerUnit,
+		DailyMinuteCap:  s.Rules.DailyMinuteCap,
+		Format:          string(s.Format),
+		Visibility:      string(s.Visibility),
+		NextStart:       s.NextStart.Format(time.RFC3339),
+		Active:          s.Active,
+	}
+}
+
+func SeasonToDTO(s *competition.Season) SeasonDTO {
+	return SeasonDTO{
+		ID:        s.ID,
+		SeriesID:  s.SeriesID,
+		Name:      s.Name,
+		StartDate: s.StartDate.Format(time.RFC3339),
+		EndDate:   s.EndDate.Format(time.RFC3339),
+		Status:    string(s.Status),
+
+		XPPolicyVersion: s.XPPolicyVersion,
+	}
+}
+
+func SeasonsToDTO(list []*competition.Season) []SeasonDTO {
+	out := make([]SeasonDTO, 0, len(list))
+	for _, s := range list {
+		out = append(out, SeasonToDTO(s))
+	}
+	return out
+}
+
+func SeasonStandingsToDTO(rows []competition.SeasonStanding, users map[string]*user.User) []SeasonStandingDTO {
+	out := make([]SeasonStandingDTO, 0, len(rows))
+	for _, r := range rows {
+		u := UserToPublicDTO(users[r.UserID])
+		if u.ID == "" {
+			u.ID = r.UserID
+		}
+		out = append(out, SeasonStandingDTO{
+			Rank:         r.Rank,
+			User:         u,
+			Points:       r.Points,
+			Competitions: r.Competitions,
+			Wins:         r.Wins,
+			XPEarned:     r.XPEarned,
+		})
+	}
+	return out
+}
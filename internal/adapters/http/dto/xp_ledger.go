@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/bakhtybayevn/powerbook/internal/domain/user"
+)
+
+type XPTransactionDTO struct {
+	ID            string `json:"id"`
+	Amount        int    `json:"amount" example:"150"`
+	Reason        string `json:"reason" example:"competition"`
+	CompetitionID string `json:"competition_id,omitempty"`
+	SeasonID      string `json:"season_id,omitempty"`
+	AchievementID string `json:"achievement_id,omitempty"`
+	CreatedAt     string `json:"created_at"`
+}
+
+func XPTransactionsToDTO(list []*user.XPTransaction) []XPTransactionDTO {
+	out := make([]XPTransactionDTO, 0, len(list))
+	for _, t := range list {
+		out = append(out, XPTransactionDTO{
+			ID:            t.ID,
+			Amount:        t.Amount,
+			Reason:        string(t.Reason),
+			CompetitionID: t.Source.CompetitionID,
+			SeasonID:      t.Source.SeasonID,
+			AchievementID: t.Source.AchievementID,
+			CreatedAt:     t.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return out
+}
@@ -0,0 +1,99 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/bakhtybayevn/powerbook/internal/domain/xp"
+)
+
+type LevelDTO struct {
+	Level int    `json:"level" example:"2"`
+	Name  string `json:"name" example:"Reader"`
+	XP    int    `json:"xp" example:"100"`
+}
+
+type XPPolicyDTO struct {
+	Version         int `json:"version"`
+	FirstPlaceXP    int `json:"first_place_xp"`
+	SecondPlaceXP   int `json:"second_place_xp"`
+	ThirdPlaceXP    int `json:"third_place_xp"`
+	TopHalfXP       int `json:"top_half_xp"`
+	BottomHalfXP    int `json:"bottom_half_xp"`
+	PerDayXP        int `json:"per_day_xp"`
+	PerfectStreakXP int `json:"perfect_streak_xp"`
+
+	SeasonFirstXP      int `json:"season_first_xp"`
+	SeasonSecondXP     int `json:"season_second_xp"`
+	SeasonThirdXP      int `json:"season_third_xp"`
+	SeasonTopHalfXP    int `json:"season_top_half_xp"`
+	SeasonBottomHalfXP int `json:"season_bottom_half_xp"`
+	SeasonWinXP        int `json:"season_win_xp"`
+
+	Levels    []LevelDTO `json:"levels"`
+	CreatedBy string     `json:"created_by,omitempty"`
+	CreatedAt string     `json:"created_at,omitempty"`
+}
+
+// UpdateXPPolicyRequest changes the XP policy; omitted fields keep their value.
+type UpdateXPPolicyRequest struct {
+	FirstPlaceXP    *int `json:"first_place_xp,omitempty" example:"250"`
+	SecondPlaceXP   *int `json:"second_place_xp,omitempty" example:"175"`
+	ThirdPlaceXP    *int `json:"third_place_xp,omitempty" example:"120"`
+	TopHalfXP       *int `json:"top_half_xp,omitempty" example:"50"`
+	BottomHalfXP    *int `json:"bottom_half_xp,omitempty" example:"20"`
+	PerDayXP        *int `json:"per_day_xp,omitempty" example:"5"`
+	PerfectStreakXP *int `json:"perfect_streak_xp,omitempty" example:"30"`
+
+	SeasonFirstXP      *int `json:"season_first_xp,omitempty" example:"500"`
+	SeasonSecondXP     *int `json:"season_second_xp,omitempty" example:"300"`
+	SeasonThirdXP      *int `json:"season_third_xp,omitempty" example:"200"`
+	SeasonTopHalfXP    *int `json:"season_top_half_xp,omitempty" example:"100"`
+	SeasonBottomHalfXP *int `json:"season_bottom_half_xp,omitempty" example:"30"`
+	SeasonWinXP        *int `json:"season_win_xp,omitempty" example:"25"` // per competition won in the season
+
+	// replaces the whole level table; ascending, the first level at 0 XP
+	Levels []UpdateLevelRequest `json:"levels,omitempty"`
+}
+
+type UpdateLevelRequest struct {
+	Name string `json:"name" example:"Reader"`
+	XP   int    `json:"xp" example:"100"`
+}
+
+func XPPolicyToDTO(p *xp.Policy) XPPolicyDTO {
+	out := XPPolicyDTO{
+		Version:         p.Version,
+		FirstPlaceXP:    p.FirstPlaceXP,
+		SecondPlaceXP:   p.SecondPlaceXP,
+		ThirdPlaceXP:    p.ThirdPlaceXP,
+		TopHalfXP:       p.TopHalfXP,
+		BottomHalfXP:    p.BottomHalfXP,
+		PerDayXP:        p.PerDayXP,
+		PerfectStreakXP: p.PerfectStreakXP,
+
+		SeasonFirstXP:      p.SeasonFirstXP,
+		SeasonSecondXP:     p.SeasonSecondXP,
+		SeasonThirdXP:      p.SeasonThirdXP,
+		SeasonTopHalfXP:    p.SeasonTopHalfXP,
+		SeasonBottomHalfXP: p.SeasonBottomHalfXP,
+		SeasonWinXP:        p.SeasonWinXP,
+
+		Levels:    make([]LevelDTO, 0, len(p.Levels)),
+		CreatedBy: p.CreatedBy,
+	}
+	for i, l := range p.Levels {
+		out.Levels = append(out.Levels, LevelDTO{Level: i + 1, Name: l.Name, XP: l.XP})
+	}
+	if !p.CreatedAt.IsZero() {
+		out.CreatedAt = p.CreatedAt.Format(time.RFC3339)
+	}
+	return out
+}
+
+func XPPoliciesToDTO(list []*xp.Policy) []XPPolicyDTO {
+	out := make([]XPPolicyDTO, 0, len(list))
+	for _, p := range list {
+		out = append(out, XPPolicyToDTO(p))
+	}
+	return out
+}
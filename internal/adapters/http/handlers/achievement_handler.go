@@ -0,0 +1,21 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/dto"
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/response"
+	"github.com/bakhtybayevn/powerbook/internal/domain/achievement"
+)
+
+// ListAchievements godoc
+// @Summary Every achievement that can be unlocked, with its XP reward
+// @Tags users
+// @Produce json
+// @Success 200 {array} dto.AchievementDTO
+// @Router /achievements [get]
+func ListAchievements() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		response.JSON(c, dto.AchievementsToDTO(achievement.Catalog()))
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/bakhtybayevn/powerbook/internal/adapters/http/middleware"
 	"github.com/bakhtybayevn/powerbook/internal/adapters/http/response"
+	appCompetition "github.com/bakhtybayevn/powerbook/internal/application/competition"
 	"github.com/bakhtybayevn/powerbook/internal/core"
 	"github.com/bakhtybayevn/powerbook/internal/ports"
 )
@@ -23,7 +24,7 @@ func requireAdmin(c *gin.Context, userRepo ports.UserRepository) bool {
 	return true
 }
 
-func AdminListUsers(userRepo ports.UserRepository) gin.HandlerFunc {
+func AdminListUsers(userRepo ports.UserRepository, policies ports.XPPolicyRepository) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		if !requireAdmin(c, userRepo) {
 			return
@@ -33,15 +34,17 @@ func AdminListUsers(userRepo ports.UserRepository) gin.HandlerFunc {
 			c.Error(err)
 			return
 		}
+		policy := currentPolicy(policies)
 		out := make([]gin.H, 0, len(users))
 		for _, u := range users {
+			level, levelName := policy.Level(u.XP)
 			out = append(out, gin.H{
 				"id":              u.ID,
 				"email":           u.Email,
 				"display_name":    u.DisplayName,
 				"xp":              u.XP,
-				"level":           u.Level(),
-				"level_name":      u.LevelName(),
+				"level":           level,
+				"level_name":      levelName,
 				"streak_current":  u.StreakCurrentDays,
 				"total_minutes":   u.TotalMinutes,
 				"telegram_handle": u.TelegramHandle,
@@ -69,3 +72,17 @@ func AdminDeleteUser(userRepo ports.UserRepository) gin.HandlerFunc {
 		response.JSON(c, gin.H{"deleted": uid})
 	}
 }
+
+func AdminRebuildLeaderboard(userRepo ports.UserRepository, reconciler *appCompetition.LeaderboardReconciler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !requireAdmin(c, userRepo) {
+			return
+		}
+		res, err := reconciler.Rebuild(c.Param("id"))
+		if err != nil {
+			c.Error(err)
+			return
+		}
+		response.JSON(c, res)
+	}
+}
@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/dto"
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/response"
+	appBook "github.com/bakhtybayevn/powerbook/internal/application/book"
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// AddBook godoc
+// @Summary Add a book to the catalog
+// @Tags books
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.AddBookRequest true "Book info"
+// @Success 200 {object} dto.BookDTO
+// @Router /books [post]
+func AddBook(handler *appBook.AddBookHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.AddBookRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(core.New(core.ValidationError, "invalid book request"))
+			return
+		}
+
+		b, err := handler.Handle(appBook.AddBookCommand{
+			Title:     req.Title,
+			Author:    req.Author,
+			ISBN:      req.ISBN,
+			PageCount: req.PageCount,
+			CoverURL:  req.CoverURL,
+		})
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		response.JSON(c, dto.BookToDTO(b))
+	}
+}
+
+// SearchBooks godoc
+// @Summary Search the book catalog by title, author or ISBN
+// @Tags books
+// @Produce json
+// @Param q query string false "Search query"
+// @Param limit query int false "Max results (default 20)"
+// @Success 200 {object} map[string]interface{}
+// @Router /books [get]
+func SearchBooks(repo ports.BookRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		limit := 20
+		if q := c.Query("limit"); q != "" {
+			if n, err := strconv.Atoi(q); err == nil && n > 0 && n <= 100 {
+				limit = n
+			}
+		}
+
+		books, err := repo.Search(c.Query("q"), limit)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		response.JSON(c, gin.H{"books": dto.BooksToDTO(books)})
+	}
+}
+
+// GetBook godoc
+// @Summary Get a catalog book by ID
+// @Tags books
+// @Produce json
+// @Param id path string true "Book ID"
+// @Success 200 {object} dto.BookDTO
+// @Router /books/{id} [get]
+func GetBook(repo ports.BookRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.Param("id")
+		if id == "" {
+			c.Error(core.New(core.ValidationError, "book id is required"))
+			return
+		}
+
+		b, err := repo.Get(id)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		response.JSON(c, dto.BookToDTO(b))
+	}
+}
@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/dto"
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/middleware"
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/response"
+	appCompetition "github.com/bakhtybayevn/powerbook/internal/application/competition"
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/user"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// GetCompetitionByInvite godoc
+// @Summary Resolve an invite link to its competition
+// @Tags competition
+// @Produce json
+// @Param code path string true "Invite code"
+// @Success 200 {object} dto.CompetitionDTO
+// @Router /competitions/invite/{code} [get]
+func GetCompetitionByInvite(compRepo ports.CompetitionRepository, userRepo ports.UserRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		code := c.Param("code")
+		if code == "" {
+			c.Error(core.New(core.ValidationError, "invite code is required"))
+			return
+		}
+
+		comp, err := compRepo.FindByInviteCode(code)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		allUsers := map[string]*user.User{}
+		for uid := range comp.Participants {
+			if u, err := userRepo.Get(uid); err == nil {
+				allUsers[uid] = u
+			}
+		}
+
+		response.JSON(c, dto.CompetitionToDTO(comp, allUsers))
+	}
+}
+
+// RegenerateInviteCode godoc
+// @Summary Issue a new invite code (organizers only); old links stop working
+// @Tags competition
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Competition ID"
+// @Success 200 {object} map[string]string
+// @Router /competitions/{id}/invite-code [post]
+func RegenerateInviteCode(handler *appCompetition.RegenerateInviteCodeHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := middleware.GetUserID(c)
+		if userID == "" {
+			c.Error(core.New(core.AuthError, "unauthorized"))
+			return
+		}
+
+		code, err := handler.Handle(appCompetition.RegenerateInviteCodeCommand{
+			ActorID:       userID,
+			CompetitionID: c.Param("id"),
+		})
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		response.JSON(c, gin.H{"invite_code": code})
+	}
+}
+
+// ListJoinRequests godoc
+// @Summary List pending join requests (organizers only)
+// @Tags competition
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Competition ID"
+// @Success 200 {object} map[string]interface{}
+// @Router /competitions/{id}/join-requests [get]
+func ListJoinRequests(handler *appCompetition.ListJoinRequestsHandler, userRepo ports.UserRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := middleware.GetUserID(c)
+		if userID == "" {
+			c.Error(core.New(core.AuthError, "unauthorized"))
+			return
+		}
+
+		list, err := handler.Handle(appCompetition.ListJoinRequestsCommand{
+			ActorID:       userID,
+			CompetitionID: c.Param("id"),
+		})
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		users := map[string]*user.User{}
+		for _, jr := range list {
+			if u, err := userRepo.Get(jr.UserID); err == nil {
+				users[jr.UserID] = u
+			}
+		}
+
+		response.JSON(c, gin.H{"requests": dto.JoinRequestsToDTO(list, users)})
+	}
+}
+
+// ApproveJoinRequest godoc
+// @Summary Approve a pending join request (organizers only)
+// @Tags competition
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Competition ID"
+// @Param userID path string true "Requesting user ID"
+// @Success 200 {object} map[string]string
+// @Router /competitions/{id}/join-requests/{userID}/approve [post]
+func ApproveJoinRequest(handler *appCompetition.DecideJoinRequestHandler) gin.HandlerFunc {
+	return decideJoinRequest(handler, true)
+}
+
+// RejectJoinRequest godoc
+// @Summary Reject a pending join request (organizers only)
+// @Tags competition
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Competition ID"
+// @Param userID path string true "Requesting user ID"
+// @Success 200 {object} map[string]string
+// @Router /competitions/{id}/join-requests/{userID}/reject [post]
+func RejectJoinRequest(handler *appCompetition.DecideJoinRequestHandler) gin.HandlerFunc {
+	return decideJoinRequest(handler, false)
+}
+
+func decideJoinRequest(handler *appCompetition.DecideJoinRequestHandler, approve bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := middleware.GetUserID(c)
+		if userID == "" {
+			c.Error(core.New(core.AuthError, "unauthorized"))
+			return
+		}
+
+		jr, err := handler.Handle(appCompetition.DecideJoinRequestCommand{
+			ActorID:       userID,
+			CompetitionID: c.Param("id"),
+			UserID:        c.Param("userID"),
+			Approve:       approve,
+		})
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		response.JSON(c, gin.H{"user_id": jr.UserID, "status": string(jr.Status)})
+	}
+}
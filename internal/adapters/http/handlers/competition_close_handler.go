@@ -3,13 +3,17 @@ package handlers
 import (
 	"github.com/gin-gonic/gin"
 
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/dto"
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/middleware"
 	"github.com/bakhtybayevn/powerbook/internal/adapters/http/response"
 	appCompetition "github.com/bakhtybayevn/powerbook/internal/application/competition"
 	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/xp"
 )
 
 // CloseCompetition godoc
-// @Summary Close competition and compute winners
+// @Summary Close competition and compute winners (organizers and admins only)
+// @Description Safe to retry: closing an already closed competition returns the original results without awarding XP again.
 // @Tags competition
 // @Security BearerAuth
 // @Produce json
@@ -18,6 +22,12 @@ import (
 // @Router /competitions/{id}/close [post]
 func CloseCompetition(handler *appCompetition.CloseCompetitionHandler) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		userID := middleware.GetUserID(c)
+		if userID == "" {
+			c.Error(core.New(core.AuthError, "unauthorized"))
+			return
+		}
+
 		competitionID := c.Param("id")
 		if competitionID == "" {
 			c.Error(core.New(core.ValidationError, "competition id is required"))
@@ -26,16 +36,23 @@ func CloseCompetition(handler *appCompetition.CloseCompetitionHandler) gin.Handl
 
 		winners, gifts, err := handler.Handle(appCompetition.CloseCompetitionCommand{
 			CompetitionID: competitionID,
+			ActorID:       userID,
 		})
 		if err != nil {
 			c.Error(err)
 			return
 		}
 
+		var levelUps []*xp.LevelUp
+		for _, w := range winners {
+			levelUps = append(levelUps, w.LevelUps...)
+		}
+
 		response.JSON(c, gin.H{
-			"closed":  "ok",
-			"winners": winners,
-			"gifts":   gifts,
+			"closed":    "ok",
+			"winners":   winners,
+			"gifts":     gifts,
+			"level_ups": dto.LevelUpsToDTO(levelUps),
 		})
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/bakhtybayevn/powerbook/internal/adapters/http/dto"
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/middleware"
 	"github.com/bakhtybayevn/powerbook/internal/adapters/http/response"
 	appCompetition "github.com/bakhtybayevn/powerbook/internal/application/competition"
 	"github.com/bakhtybayevn/powerbook/internal/core"
@@ -28,23 +29,24 @@ func CreateCompetition(handler *appCompetition.CreateCompetitionHandler) gin.Han
 		}
 
 		cmp, err := handler.Handle(appCompetition.CreateCompetitionCommand{
-			Name:            req.Name,
-			StartDate:       req.StartDate,
-			EndDate:         req.EndDate,
-			PointsPerMinute: req.PointsPerMinute,
+			UserID:           middleware.GetUserID(c),
+			Name:             req.Name,
+			StartDate:        req.StartDate,
+			EndDate:          req.EndDate,
+			PointsPerMinute:  req.PointsPerMinute,
+			ScoringType:      req.ScoringType,
+			PointsPerUnit:    req.PointsPerUnit,
+			DailyMinuteCap:   req.DailyMinuteCap,
+			Format:           req.Format,
+			Visibility:       req.Visibility,
+			RequiresApproval: req.RequiresApproval,
+			MaxParticipants:  req.MaxParticipants,
 		})
 		if err != nil {
 			c.Error(err)
 			return
 		}
 
-		response.JSON(c, dto.CompetitionResponse{
-			ID:              cmp.ID,
-			Name:            cmp.Name,
-			StartDate:       cmp.StartDate,
-			EndDate:         cmp.EndDate,
-			Status:          string(cmp.Status),
-			PointsPerMinute: cmp.Rules.PointsPerMinute,
-		})
+		response.JSON(c, competitionResponse(cmp))
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/bakhtybayevn/powerbook/internal/adapters/http/dto"
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/middleware"
 	"github.com/bakhtybayevn/powerbook/internal/adapters/http/response"
 	"github.com/bakhtybayevn/powerbook/internal/core"
 	"github.com/bakhtybayevn/powerbook/internal/domain/user"
@@ -24,6 +25,12 @@ func GetCompetition(compRepo ports.CompetitionRepository, userRepo ports.UserRep
 			return
 		}
 
+		viewerID := middleware.GetUserID(c)
+		if !comp.VisibleTo(viewerID) {
+			c.Error(core.New(core.NotFoundError, "competition not found"))
+			return
+		}
+
 		allUsers := map[string]*user.User{}
 		for uid := range comp.Participants {
 			if u, err := userRepo.Get(uid); err == nil {
@@ -31,6 +38,10 @@ func GetCompetition(compRepo ports.CompetitionRepository, userRepo ports.UserRep
 			}
 		}
 
-		response.JSON(c, dto.CompetitionToDTO(comp, allUsers))
+		out := dto.CompetitionToDTO(comp, allUsers)
+		if comp.IsOrganizer(viewerID) {
+			out.InviteCode = comp.InviteCode
+		}
+		response.JSON(c, out)
 	}
 }
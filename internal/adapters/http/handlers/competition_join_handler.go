@@ -3,6 +3,7 @@ package handlers
 import (
 	"github.com/gin-gonic/gin"
 
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/dto"
 	"github.com/bakhtybayevn/powerbook/internal/adapters/http/middleware"
 	"github.com/bakhtybayevn/powerbook/internal/adapters/http/response"
 	appCompetition "github.com/bakhtybayevn/powerbook/internal/application/competition"
@@ -16,6 +17,7 @@ import (
 // @Accept json
 // @Produce json
 // @Param id path string true "Competition ID"
+// @Param request body dto.JoinCompetitionRequest false "Team (team competitions) and invite code (private competitions)"
 // @Success 200 {object} map[string]string
 // @Router /competitions/{id}/join [post]
 func JoinCompetition(handler *appCompetition.JoinCompetitionHandler) gin.HandlerFunc {
@@ -32,9 +34,15 @@ func JoinCompetition(handler *appCompetition.JoinCompetitionHandler) gin.Handler
 			return
 		}
 
-		err := handler.Handle(appCompetition.JoinCompetitionCommand{
+		// body is optional: only team and private competitions need one
+		var req dto.JoinCompetitionRequest
+		_ = c.ShouldBindJSON(&req)
+
+		res, err := handler.Handle(appCompetition.JoinCompetitionCommand{
 			UserID:        userID,
 			CompetitionID: competitionID,
+			TeamID:        req.TeamID,
+			InviteCode:    req.InviteCode,
 		})
 
 		if err != nil {
@@ -42,6 +50,10 @@ func JoinCompetition(handler *appCompetition.JoinCompetitionHandler) gin.Handler
 			return
 		}
 
+		if res.Status == appCompetition.JoinStatusPending {
+			response.JSON(c, gin.H{"joined": "pending", "message": "join request sent to the organizer"})
+			return
+		}
 		response.JSON(c, gin.H{"joined": "ok"})
 	}
 }
@@ -13,10 +13,11 @@ import (
 type LeaderboardHandler struct {
 	LB       ports.LeaderboardPort
 	UserRepo ports.UserRepository
+	CompRepo ports.CompetitionRepository
 }
 
-func NewLeaderboardHandler(lb ports.LeaderboardPort, userRepo ports.UserRepository) *LeaderboardHandler {
-	return &LeaderboardHandler{LB: lb, UserRepo: userRepo}
+func NewLeaderboardHandler(lb ports.LeaderboardPort, userRepo ports.UserRepository, compRepo ports.CompetitionRepository) *LeaderboardHandler {
+	return &LeaderboardHandler{LB: lb, UserRepo: userRepo, CompRepo: compRepo}
 }
 
 // GetLeaderboard godoc
@@ -64,3 +65,58 @@ func (h *LeaderboardHandler) GetLeaderboard(c *gin.Context) {
 
 	response.JSON(c, out)
 }
+
+// GetTeamLeaderboard godoc
+// @Summary Get team leaderboard of a team competition
+// @Tags competition
+// @Produce json
+// @Param id path string true "Competition ID"
+// @Param limit query int false "Top N (default 50)"
+// @Success 200 {array} map[string]interface{}
+// @Router /competitions/{id}/leaderboard/teams [get]
+func (h *LeaderboardHandler) GetTeamLeaderboard(c *gin.Context) {
+	competitionID := c.Param("id")
+	if competitionID == "" {
+		c.Error(core.New(core.ValidationError, "competition id is required"))
+		return
+	}
+
+	limit := 50
+	if q := c.Query("limit"); q != "" {
+		if n, err := strconv.Atoi(q); err == nil {
+			limit = n
+		}
+	}
+
+	cmp, err := h.CompRepo.Get(competitionID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	if !cmp.IsTeamCompetition() {
+		c.Error(core.New(core.ValidationError, "competition is not a team competition"))
+		return
+	}
+
+	rows, err := h.LB.GetTopTeams(c, competitionID, limit)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	out := make([]gin.H, 0, len(rows))
+	for i, r := range rows {
+		name := "Unknown"
+		if t, ok := cmp.Teams[r.TeamID]; ok {
+			name = t.Name
+		}
+		out = append(out, gin.H{
+			"rank":      i + 1,
+			"team_id":   r.TeamID,
+			"team_name": name,
+			"points":    r.Score,
+		})
+	}
+
+	response.JSON(c, out)
+}
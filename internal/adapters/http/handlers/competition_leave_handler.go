@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/dto"
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/middleware"
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/response"
+	appCompetition "github.com/bakhtybayevn/powerbook/internal/application/competition"
+	"github.com/bakhtybayevn/powerbook/internal/core"
+)
+
+// LeaveCompetition godoc
+// @Summary Leave a competition
+// @Tags competition
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Competition ID"
+// @Success 200 {object} map[string]string
+// @Router /competitions/{id}/leave [post]
+func LeaveCompetition(handler *appCompetition.LeaveCompetitionHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := middleware.GetUserID(c)
+		if userID == "" {
+			c.Error(core.New(core.AuthError, "unauthorized"))
+			return
+		}
+
+		err := handler.Handle(appCompetition.LeaveCompetitionCommand{
+			UserID:        userID,
+			CompetitionID: c.Param("id"),
+		})
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		response.JSON(c, gin.H{"left": "ok"})
+	}
+}
+
+// KickParticipant godoc
+// @Summary Remove a participant and block them from re-joining (organizers and admins only)
+// @Tags competition
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Competition ID"
+// @Param userID path string true "Participant user ID"
+// @Param request body dto.KickParticipantRequest false "Optional reason"
+// @Success 200 {object} map[string]string
+// @Router /competitions/{id}/participants/{userID}/kick [post]
+func KickParticipant(handler *appCompetition.KickParticipantHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := middleware.GetUserID(c)
+		if userID == "" {
+			c.Error(core.New(core.AuthError, "unauthorized"))
+			return
+		}
+
+		// body is optional
+		var req dto.KickParticipantRequest
+		_ = c.ShouldBindJSON(&req)
+
+		err := handler.Handle(appCompetition.KickParticipantCommand{
+			ActorID:       userID,
+			CompetitionID: c.Param("id"),
+			UserID:        c.Param("userID"),
+			Reason:        req.Reason,
+		})
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		response.JSON(c, gin.H{"kicked": "ok"})
+	}
+}
@@ -9,7 +9,7 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-// @Summary List all competitions
+// @Summary List public competitions (plus unlisted/private ones the caller belongs to)
 // @Tags competition
 // @Security BearerAuth
 // @Produce json
@@ -17,7 +17,7 @@ import (
 // @Router /competitions [get]
 func ListAllCompetitions(h *competition.ListAllCompetitionsHandler) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		comps, users, err := h.Handle()
+		comps, users, err := h.Handle(competition.ListAllCommand{ViewerID: middleware.GetUserID(c)})
 		if err != nil {
 			c.Error(err)
 			return
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/dto"
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/middleware"
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/response"
+	appCompetition "github.com/bakhtybayevn/powerbook/internal/application/competition"
+	"github.com/bakhtybayevn/powerbook/internal/core"
+)
+
+// AddOrganizer godoc
+// @Summary Appoint a co-organizer (owner or admin only)
+// @Tags competition
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Competition ID"
+// @Param request body dto.AddOrganizerRequest true "User to appoint"
+// @Success 200 {object} map[string]string
+// @Router /competitions/{id}/organizers [post]
+func AddOrganizer(handler *appCompetition.AddOrganizerHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := middleware.GetUserID(c)
+		if userID == "" {
+			c.Error(core.New(core.AuthError, "unauthorized"))
+			return
+		}
+
+		var req dto.AddOrganizerRequest
+		if err := c.ShouldBindJSON(&req); err != nil || req.UserID == "" {
+			c.Error(core.New(core.ValidationError, "user_id is required"))
+			return
+		}
+
+		err := handler.Handle(appCompetition.ManageOrganizerCommand{
+			ActorID:       userID,
+			CompetitionID: c.Param("id"),
+			UserID:        req.UserID,
+		})
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		response.JSON(c, gin.H{"organizer": "added"})
+	}
+}
+
+// RemoveOrganizer godoc
+// @Summary Remove a co-organizer (owner or admin only)
+// @Tags competition
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Competition ID"
+// @Param userID path string true "Organizer user ID"
+// @Success 200 {object} map[string]string
+// @Router /competitions/{id}/organizers/{userID} [delete]
+func RemoveOrganizer(handler *appCompetition.RemoveOrganizerHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := middleware.GetUserID(c)
+		if userID == "" {
+			c.Error(core.New(core.AuthError, "unauthorized"))
+			return
+		}
+
+		err := handler.Handle(appCompetition.ManageOrganizerCommand{
+			ActorID:       userID,
+			CompetitionID: c.Param("id"),
+			UserID:        c.Param("userID"),
+		})
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		response.JSON(c, gin.H{"organizer": "removed"})
+	}
+}
+
+// DeleteCompetition godoc
+// @Summary Delete a competition (organizers and admins only)
+// @Tags competition
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Competition ID"
+// @Success 200 {object} map[string]string
+// @Router /competitions/{id} [delete]
+func DeleteCompetition(handler *appCompetition.DeleteCompetitionHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := middleware.GetUserID(c)
+		if userID == "" {
+			c.Error(core.New(core.AuthError, "unauthorized"))
+			return
+		}
+
+		err := handler.Handle(appCompetition.DeleteCompetitionCommand{
+			ActorID:       userID,
+			CompetitionID: c.Param("id"),
+		})
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		response.JSON(c, gin.H{"deleted": "ok"})
+	}
+}
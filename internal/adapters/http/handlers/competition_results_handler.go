@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/dto"
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/middleware"
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/response"
+	appCompetition "github.com/bakhtybayevn/powerbook/internal/application/competition"
+	"github.com/bakhtybayevn/powerbook/internal/domain/user"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// GetCompetitionResults godoc
+// @Summary Final standings of a closed competition (rank, points, XP awarded, gift group)
+// @Tags competition
+// @Produce json
+// @Param id path string true "Competition ID"
+// @Success 200 {object} map[string]interface{}
+// @Router /competitions/{id}/results [get]
+func GetCompetitionResults(handler *appCompetition.CompetitionResultsHandler, userRepo ports.UserRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		cmp, rows, err := handler.Handle(appCompetition.CompetitionResultsQuery{
+			CompetitionID: c.Param("id"),
+			ViewerID:      middleware.GetUserID(c),
+		})
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		users := map[string]*user.User{}
+		for _, r := range rows {
+			if u, err := userRepo.Get(r.UserID); err == nil {
+				users[r.UserID] = u
+			}
+		}
+
+		response.JSON(c, gin.H{
+			"competition_id":    cmp.ID,
+			"name":              cmp.Name,
+			"format":            string(cmp.Format),
+			"xp_policy_version": cmp.XPPolicyVersion,
+			"results":           dto.ResultsToDTO(rows, users),
+		})
+	}
+}
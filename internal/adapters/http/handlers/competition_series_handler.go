@@ -0,0 +1,185 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/dto"
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/middleware"
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/response"
+	appCompetition "github.com/bakhtybayevn/powerbook/internal/application/competition"
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/user"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// CreateSeries godoc
+// @Summary Create a recurring competition series (a new competition is created every period)
+// @Tags series
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateSeriesRequest true "Series template"
+// @Success 200 {object} dto.SeriesDTO
+// @Router /series [post]
+func CreateSeries(handler *appCompetition.CreateSeriesHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.CreateSeriesRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(core.New(core.ValidationError, "invalid series request"))
+			return
+		}
+
+		s, err := handler.Handle(appCompetition.CreateSeriesCommand{
+			UserID:           middleware.GetUserID(c),
+			Name:             req.Name,
+			Recurrence:       req.Recurrence,
+			FirstStart:       req.FirstStart,
+			PointsPerMinute:  req.PointsPerMinute,
+			ScoringType:      req.ScoringType,
+			PointsPerUnit:    req.PointsPerUnit,
+			DailyMinuteCap:   req.DailyMinuteCap,
+			Format:           req.Format,
+			Visibility:       req.Visibility,
+			RequiresApproval: req.RequiresApproval,
+			MaxParticipants:  req.MaxParticipants,
+		})
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		response.JSON(c, dto.SeriesToDTO(s))
+	}
+}
+
+// GetSeries godoc
+// @Summary Get a series with its competitions and seasons
+// @Tags series
+// @Produce json
+// @Param id path string true "Series ID"
+// @Success 200 {object} map[string]interface{}
+// @Router /series/{id} [get]
+func GetSeries(seriesRepo ports.SeriesRepository, compRepo ports.CompetitionRepository, userRepo ports.UserRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		s, err := seriesRepo.GetSeries(c.Param("id"))
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		comps, err := compRepo.FindBySeries(s.ID)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+		seasons, err := seriesRepo.ListSeasons(s.ID)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		allUsers := map[string]*user.User{}
+		for _, cmp := range comps {
+			for uid := range cmp.Participants {
+				if _, ok := allUsers[uid]; ok {
+					continue
+				}
+				if u, err := userRepo.Get(uid); err == nil {
+					allUsers[uid] = u
+				}
+			}
+		}
+
+		response.JSON(c, gin.H{
+			"series":       dto.SeriesToDTO(s),
+			"competitions": dto.CompetitionsToDTO(comps, allUsers),
+			"seasons":      dto.SeasonsToDTO(seasons),
+		})
+	}
+}
+
+// StopSeries godoc
+// @Summary Stop creating new competitions for a series (owner or admin only)
+// @Tags series
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Series ID"
+// @Success 200 {object} dto.SeriesDTO
+// @Router /series/{id}/stop [post]
+func StopSeries(handler *appCompetition.StopSeriesHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		s, err := handler.Handle(appCompetition.StopSeriesCommand{
+			ActorID:  middleware.GetUserID(c),
+			SeriesID: c.Param("id"),
+		})
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		response.JSON(c, dto.SeriesToDTO(s))
+	}
+}
+
+// CreateSeason godoc
+// @Summary Create a season that sums up a series' results (owner or admin only)
+// @Tags series
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Series ID"
+// @Param request body dto.CreateSeasonRequest true "Season window"
+// @Success 200 {object} dto.SeasonDTO
+// @Router /series/{id}/seasons [post]
+func CreateSeason(handler *appCompetition.CreateSeasonHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.CreateSeasonRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(core.New(core.ValidationError, "invalid season request"))
+			return
+		}
+
+		season, err := handler.Handle(appCompetition.CreateSeasonCommand{
+			ActorID:   middleware.GetUserID(c),
+			SeriesID:  c.Param("id"),
+			Name:      req.Name,
+			StartDate: req.StartDate,
+			EndDate:   req.EndDate,
+		})
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		response.JSON(c, dto.SeasonToDTO(season))
+	}
+}
+
+// GetSeasonStandings godoc
+// @Summary Season standings table (live while open, final once closed)
+// @Tags series
+// @Produce json
+// @Param id path string true "Season ID"
+// @Success 200 {object} map[string]interface{}
+// @Router /seasons/{id}/standings [get]
+func GetSeasonStandings(handler *appCompetition.SeasonStandingsHandler, userRepo ports.UserRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		season, rows, err := handler.Handle(c.Param("id"))
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		users := map[string]*user.User{}
+		for _, r := range rows {
+			if u, err := userRepo.Get(r.UserID); err == nil {
+				users[r.UserID] = u
+			}
+		}
+
+		response.JSON(c, gin.H{
+			"season":    dto.SeasonToDTO(season),
+			"standings": dto.SeasonStandingsToDTO(rows, users),
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/dto"
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/middleware"
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/response"
+	appCompetition "github.com/bakhtybayevn/powerbook/internal/application/competition"
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// CreateTeam godoc
+// @Summary Create a team in a team competition (creator joins it as captain)
+// @Tags competition
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Competition ID"
+// @Param request body dto.CreateTeamRequest true "Team info"
+// @Success 200 {object} dto.TeamDTO
+// @Router /competitions/{id}/teams [post]
+func CreateTeam(handler *appCompetition.CreateTeamHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := middleware.GetUserID(c)
+		if userID == "" {
+			c.Error(core.New(core.AuthError, "unauthorized"))
+			return
+		}
+
+		var req dto.CreateTeamRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(core.New(core.ValidationError, "invalid team request"))
+			return
+		}
+
+		team, err := handler.Handle(appCompetition.CreateTeamCommand{
+			UserID:        userID,
+			CompetitionID: c.Param("id"),
+			Name:          req.Name,
+			InviteCode:    req.InviteCode,
+		})
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		response.JSON(c, dto.TeamDTO{
+			ID:        team.ID,
+			Name:      team.Name,
+			CaptainID: team.CaptainID,
+			Members:   1,
+		})
+	}
+}
+
+// ListTeams godoc
+// @Summary List teams of a competition with summed member points
+// @Tags competition
+// @Produce json
+// @Param id path string true "Competition ID"
+// @Success 200 {object} map[string]interface{}
+// @Router /competitions/{id}/teams [get]
+func ListTeams(compRepo ports.CompetitionRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.Param("id")
+		if id == "" {
+			c.Error(core.New(core.ValidationError, "competition id is required"))
+			return
+		}
+
+		cmp, err := compRepo.Get(id)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		response.JSON(c, gin.H{"teams": dto.TeamsToDTO(cmp)})
+	}
+}
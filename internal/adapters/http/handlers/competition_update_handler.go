@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/dto"
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/middleware"
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/response"
+	appCompetition "github.com/bakhtybayevn/powerbook/internal/application/competition"
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/competition"
+)
+
+// UpdateCompetition godoc
+// @Summary Rename, reschedule or change rules of a competition (organizers and admins only)
+// @Tags competition
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Competition ID"
+// @Param request body dto.UpdateCompetitionRequest true "Fields to change"
+// @Success 200 {object} dto.CompetitionResponse
+// @Router /competitions/{id} [put]
+func UpdateCompetition(handler *appCompetition.UpdateCompetitionHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := middleware.GetUserID(c)
+		if userID == "" {
+			c.Error(core.New(core.AuthError, "unauthorized"))
+			return
+		}
+
+		var req dto.UpdateCompetitionRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(core.New(core.ValidationError, "invalid competition request"))
+			return
+		}
+
+		cmp, err := handler.Handle(appCompetition.UpdateCompetitionCommand{
+			ActorID:          userID,
+			CompetitionID:    c.Param("id"),
+			Name:             req.Name,
+			StartDate:        req.StartDate,
+			EndDate:          req.EndDate,
+			PointsPerMinute:  req.PointsPerMinute,
+			ScoringType:      req.ScoringType,
+			PointsPerUnit:    req.PointsPerUnit,
+			DailyMinuteCap:   req.DailyMinuteCap,
+			Visibility:       req.Visibility,
+			RequiresApproval: req.RequiresApproval,
+			MaxParticipants:  req.MaxParticipants,
+		})
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		response.JSON(c, competitionResponse(cmp))
+	}
+}
+
+// CancelCompetition godoc
+// @Summary Cancel a competition without awarding XP (organizers and admins only)
+// @Tags competition
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Competition ID"
+// @Success 200 {object} dto.CompetitionResponse
+// @Router /competitions/{id}/cancel [post]
+func CancelCompetition(handler *appCompetition.CancelCompetitionHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := middleware.GetUserID(c)
+		if userID == "" {
+			c.Error(core.New(core.AuthError, "unauthorized"))
+			return
+		}
+
+		cmp, err := handler.Handle(appCompetition.CancelCompetitionCommand{
+			ActorID:       userID,
+			CompetitionID: c.Param("id"),
+		})
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		response.JSON(c, competitionResponse(cmp))
+	}
+}
+
+func competitionResponse(cmp *competition.Competition) dto.CompetitionResponse {
+	return dto.CompetitionResponse{
+		ID:               cmp.ID,
+		Name:             cmp.Name,
+		StartDate:        cmp.StartDate,
+		EndDate:          cmp.EndDate,
+		Status:           string(cmp.Status),
+		PointsPerMinute:  cmp.Rules.PointsPerMinute,
+		ScoringType:      string(cmp.Rules.ScoringType()),
+		PointsPerUnit:    cmp.Rules.PointsPerUnit,
+		DailyMinuteCap:   cmp.Rules.DailyMinuteCap,
+		Format:           string(cmp.Format),
+		OwnerID:          cmp.OwnerID,
+		Visibility:       string(cmp.Visibility),
+		InviteCode:       cmp.InviteCode,
+		RequiresApproval: cmp.RequiresApproval,
+		MaxParticipants:  cmp.MaxParticipants,
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/bakhtybayevn/powerbook/internal/adapters/http/middleware"
 	"github.com/bakhtybayevn/powerbook/internal/adapters/http/response"
+	appCompetition "github.com/bakhtybayevn/powerbook/internal/application/competition"
 	"github.com/bakhtybayevn/powerbook/internal/core"
 	"github.com/bakhtybayevn/powerbook/internal/ports"
 )
@@ -53,7 +54,7 @@ func GetGiftExchanges(repo ports.CompetitionRepository, userRepo ports.UserRepos
 }
 
 // ConfirmGift allows giver to confirm giving (with description) or receiver to confirm receiving.
-func ConfirmGift(repo ports.CompetitionRepository) gin.HandlerFunc {
+func ConfirmGift(handler *appCompetition.ConfirmGiftHandler) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		userID := middleware.GetUserID(c)
 		if userID == "" {
@@ -61,40 +62,21 @@ func ConfirmGift(repo ports.CompetitionRepository) gin.HandlerFunc {
 			return
 		}
 
-		giftID := c.Param("giftId")
-		if giftID == "" {
-			c.Error(core.New(core.ValidationError, "gift id is required"))
-			return
-		}
-
 		var req struct {
 			GiftDescription string `json:"gift_description"`
 		}
 		c.ShouldBindJSON(&req)
 
-		g, err := repo.GetGiftExchange(giftID)
+		g, err := handler.Handle(appCompetition.ConfirmGiftCommand{
+			GiftID:          c.Param("giftId"),
+			UserID:          userID,
+			GiftDescription: req.GiftDescription,
+		})
 		if err != nil {
 			c.Error(err)
 			return
 		}
 
-		if userID == g.GiverID {
-			g.GiverConfirmed = true
-		} else if userID == g.ReceiverID {
-			g.ReceiverConfirmed = true
-		} else {
-			c.Error(core.New(core.AuthError, "you are not part of this gift exchange"))
-			return
-		}
-		if req.GiftDescription != "" {
-			g.GiftDescription = req.GiftDescription
-		}
-
-		if err := repo.UpdateGiftExchange(g); err != nil {
-			c.Error(err)
-			return
-		}
-
 		response.JSON(c, gin.H{
 			"id":                 g.ID,
 			"giver_confirmed":    g.GiverConfirmed,
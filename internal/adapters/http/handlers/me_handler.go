@@ -3,8 +3,10 @@ package handlers
 import (
 	"github.com/gin-gonic/gin"
 
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/dto"
 	"github.com/bakhtybayevn/powerbook/internal/adapters/http/middleware"
 	"github.com/bakhtybayevn/powerbook/internal/adapters/http/response"
+	appXP "github.com/bakhtybayevn/powerbook/internal/application/xp"
 	"github.com/bakhtybayevn/powerbook/internal/core"
 	"github.com/bakhtybayevn/powerbook/internal/ports"
 )
@@ -16,7 +18,7 @@ import (
 // @Produce json
 // @Success 200 {object} map[string]interface{}
 // @Router /users/me [get]
-func GetMe(repo ports.UserRepository) gin.HandlerFunc {
+func GetMe(repo ports.UserRepository, freezeRepo ports.StreakFreezeRepository, policies ports.XPPolicyRepository, achievements ports.AchievementRepository, perks *appXP.PerksReader) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		userID := middleware.GetUserID(c)
 
@@ -26,17 +28,29 @@ func GetMe(repo ports.UserRepository) gin.HandlerFunc {
 			return
 		}
 
+		freezeHistory, _ := freezeRepo.ListByUser(u.ID)
+		badges, _ := achievements.ListByUser(u.ID)
+		userPerks, _ := perks.For(u)
+		level, levelName := currentPolicy(policies).Level(u.XP)
+
 		response.JSON(c, gin.H{
-			"id":              u.ID,
-			"email":           u.Email,
-			"display_name":    u.DisplayName,
-			"streak_current":  u.StreakCurrentDays,
-			"total_minutes":   u.TotalMinutes,
-			"xp":              u.XP,
-			"level":           u.Level(),
-			"level_name":      u.LevelName(),
-			"telegram_handle": u.TelegramHandle,
-			"is_admin":        u.IsAdmin,
+			"id":                    u.ID,
+			"email":                 u.Email,
+			"display_name":          u.DisplayName,
+			"streak_current":        u.StreakCurrentDays,
+			"total_minutes":         u.TotalMinutes,
+			"xp":                    u.XP,
+			"level":                 level,
+			"level_name":            levelName,
+			"flair":                 userPerks.Flair,
+			"telegram_handle":       u.TelegramHandle,
+			"is_admin":              u.IsAdmin,
+			"timezone":              u.Timezone,
+			"streak_freezes":        u.StreakFreezes,
+			"streak_freeze_history": streakFreezeHistoryToJSON(freezeHistory),
+			"badges":                dto.BadgesToDTO(badges),
+
+			"can_create_public_competitions": u.IsAdmin || userPerks.PublicCompetitions,
 		})
 	}
 }
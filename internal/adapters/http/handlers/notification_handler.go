@@ -0,0 +1,178 @@
+package handlers
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/dto"
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/middleware"
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/response"
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// ListNotifications godoc
+// @Summary The authenticated user's notifications, newest first
+// @Tags notifications
+// @Security BearerAuth
+// @Produce json
+// @Param unread query bool false "Only unread notifications"
+// @Param limit query int false "Max results (default 50)"
+// @Success 200 {object} map[string]interface{}
+// @Router /notifications [get]
+func ListNotifications(inbox ports.NotificationRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := middleware.GetUserID(c)
+		if userID == "" {
+			c.Error(core.New(core.AuthError, "unauthorized"))
+			return
+		}
+
+		limit := 50
+		if q := c.Query("limit"); q != "" {
+			if n, err := strconv.Atoi(q); err == nil && n > 0 && n <= 100 {
+				limit = n
+			}
+		}
+		unreadOnly := c.Query("unread") == "true"
+
+		list, err := inbox.ListByUser(userID, unreadOnly, limit)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+		unread, err := inbox.CountUnread(userID)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		response.JSON(c, gin.H{
+			"notifications": dto.NotificationsToDTO(list),
+			"unread_count":  unread,
+		})
+	}
+}
+
+// MarkNotificationRead godoc
+// @Summary Mark one notification as read
+// @Tags notifications
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Notification ID"
+// @Success 200 {object} map[string]interface{}
+// @Router /notifications/{id}/read [post]
+func MarkNotificationRead(inbox ports.NotificationRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := middleware.GetUserID(c)
+		if userID == "" {
+			c.Error(core.New(core.AuthError, "unauthorized"))
+			return
+		}
+
+		if err := inbox.MarkRead(userID, c.Param("id"), time.Now().UTC()); err != nil {
+			c.Error(err)
+			return
+		}
+
+		response.JSON(c, gin.H{"read": "ok"})
+	}
+}
+
+// MarkAllNotificationsRead godoc
+// @Summary Mark every notification as read
+// @Tags notifications
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} map[string]interface{}
+// @Router /notifications/read-all [post]
+func MarkAllNotificationsRead(inbox ports.NotificationRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := middleware.GetUserID(c)
+		if userID == "" {
+			c.Error(core.New(core.AuthError, "unauthorized"))
+			return
+		}
+
+		n, err := inbox.MarkAllRead(userID, time.Now().UTC())
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		response.JSON(c, gin.H{"marked": n})
+	}
+}
+
+// GetNotificationPreferences godoc
+// @Summary Channels the authenticated user gets notifications on besides the inbox
+// @Tags notifications
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} dto.NotificationPreferencesDTO
+// @Router /notifications/preferences [get]
+func GetNotificationPreferences(prefs ports.NotificationPreferenceRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := middleware.GetUserID(c)
+		if userID == "" {
+			c.Error(core.New(core.AuthError, "unauthorized"))
+			return
+		}
+
+		p, err := prefs.Get(userID)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		response.JSON(c, dto.NotificationPreferencesDTO{Email: p.Email, Telegram: p.Telegram})
+	}
+}
+
+// UpdateNotificationPreferences godoc
+// @Summary Turn notification channels on or off; omitted channels keep their setting
+// @Tags notifications
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body map[string]bool true "Channels, e.g. {\"email\": false}"
+// @Success 200 {object} dto.NotificationPreferencesDTO
+// @Router /notifications/preferences [put]
+func UpdateNotificationPreferences(prefs ports.NotificationPreferenceRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := middleware.GetUserID(c)
+		if userID == "" {
+			c.Error(core.New(core.AuthError, "unauthorized"))
+			return
+		}
+
+		var req struct {
+			Email    *bool `json:"email"`
+			Telegram *bool `json:"telegram"`
+		}
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(core.New(core.ValidationError, "invalid preferences request"))
+			return
+		}
+
+		p, err := prefs.Get(userID)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+		if req.Email != nil {
+			p.Email = *req.Email
+		}
+		if req.Telegram != nil {
+			p.Telegram = *req.Telegram
+		}
+		if err := prefs.Save(p); err != nil {
+			c.Error(err)
+			return
+		}
+
+		response.JSON(c, dto.NotificationPreferencesDTO{Email: p.Email, Telegram: p.Telegram})
+	}
+}
@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/dto"
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/middleware"
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/response"
+	appUser "github.com/bakhtybayevn/powerbook/internal/application/user"
+	"github.com/bakhtybayevn/powerbook/internal/core"
+)
+
+// ChangePassword godoc
+// @Summary Change the password of the authenticated user
+// @Description Signs out every other session and returns a fresh token for this one
+// @Tags auth
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.ChangePasswordRequest true "Current and new password"
+// @Success 200 {object} dto.LoginResponse
+// @Router /users/me/password [put]
+func ChangePassword(handler *appUser.ChangePasswordHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := middleware.GetUserID(c)
+		if userID == "" {
+			c.Error(core.New(core.AuthError, "unauthorized"))
+			return
+		}
+
+		var req dto.ChangePasswordRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(core.New(core.ValidationError, err.Error()))
+			return
+		}
+
+		token, err := handler.Handle(appUser.ChangePasswordCommand{
+			UserID:          userID,
+			CurrentPassword: req.CurrentPassword,
+			NewPassword:     req.NewPassword,
+		})
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		response.JSON(c, dto.LoginResponse{Token: token})
+	}
+}
+
+// ForgotPassword godoc
+// @Summary Email a password reset link
+// @Description Always succeeds for a well-formed request, whether or not the email has an account
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body dto.ForgotPasswordRequest true "Account email"
+// @Success 200 {object} map[string]interface{}
+// @Router /users/password/forgot [post]
+func ForgotPassword(handler *appUser.RequestPasswordResetHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.ForgotPasswordRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(core.New(core.ValidationError, err.Error()))
+			return
+		}
+
+		if err := handler.Handle(appUser.RequestPasswordResetCommand{Email: req.Email}); err != nil {
+			c.Error(err)
+			return
+		}
+
+		response.JSON(c, gin.H{"message": "if the email has an account, a reset link is on its way"})
+	}
+}
+
+// ResetPassword godoc
+// @Summary Set a new password with a reset token
+// @Description The token works once; every existing session is signed out
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body dto.ResetPasswordRequest true "Reset token and new password"
+// @Success 200 {object} map[string]interface{}
+// @Router /users/password/reset [post]
+func ResetPassword(handler *appUser.ResetPasswordHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.ResetPasswordRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(core.New(core.ValidationError, err.Error()))
+			return
+		}
+
+		if err := handler.Handle(appUser.ResetPasswordCommand{Token: req.Token, NewPassword: req.NewPassword}); err != nil {
+			c.Error(err)
+			return
+		}
+
+		response.JSON(c, gin.H{"reset": "ok"})
+	}
+}
@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/dto"
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/middleware"
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/response"
+	appReading "github.com/bakhtybayevn/powerbook/internal/application/reading"
+	"github.com/bakhtybayevn/powerbook/internal/core"
+)
+
+// UpdateReading godoc
+// @Summary Edit a reading log entry and recompute derived stats
+// @Tags reading
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "Reading ID"
+// @Param request body dto.UpdateReadingRequest true "Updated reading data"
+// @Success 200 {object} dto.LogReadingResponse
+// @Router /reading/{id} [put]
+func UpdateReading(handler *appReading.UpdateReadingHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := middleware.GetUserID(c)
+		if userID == "" {
+			c.Error(core.New(core.AuthError, "unauthorized"))
+			return
+		}
+
+		var req dto.UpdateReadingRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(core.New(core.ValidationError, "invalid request payload"))
+			return
+		}
+
+		newStreak, totalMinutes, err := handler.Handle(appReading.UpdateReadingCommand{
+			UserID:       userID,
+			ReadingID:    c.Param("id"),
+			Minutes:      req.Minutes,
+			Source:       req.Source,
+			Timestamp:    req.Timestamp,
+			BookID:       req.BookID,
+			PagesRead:    req.PagesRead,
+			FinishedBook: req.FinishedBook,
+		})
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		response.JSON(c, dto.LogReadingResponse{
+			NewStreak:          newStreak,
+			TotalMinutesLogged: totalMinutes,
+		})
+	}
+}
+
+// DeleteReading godoc
+// @Summary Delete a reading log entry and recompute derived stats
+// @Tags reading
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "Reading ID"
+// @Success 200 {object} dto.LogReadingResponse
+// @Router /reading/{id} [delete]
+func DeleteReading(handler *appReading.DeleteReadingHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := middleware.GetUserID(c)
+		if userID == "" {
+			c.Error(core.New(core.AuthError, "unauthorized"))
+			return
+		}
+
+		newStreak, totalMinutes, err := handler.Handle(appReading.DeleteReadingCommand{
+			UserID:    userID,
+			ReadingID: c.Param("id"),
+		})
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		response.JSON(c, dto.LogReadingResponse{
+			NewStreak:          newStreak,
+			TotalMinutesLogged: totalMinutes,
+		})
+	}
+}
@@ -42,11 +42,14 @@ func LogReading(handler *appReading.LogReadingHandler) gin.HandlerFunc {
 			ts = req.Timestamp.UTC()
 		}
 
-		newStreak, totalMinutes, err := handler.Handle(appReading.LogReadingCommand{
-			UserID:    userID,
-			Minutes:   req.Minutes,
-			Source:    req.Source,
-			Timestamp: ts,
+		res, err := handler.Handle(appReading.LogReadingCommand{
+			UserID:       userID,
+			Minutes:      req.Minutes,
+			Source:       req.Source,
+			Timestamp:    ts,
+			BookID:       req.BookID,
+			PagesRead:    req.PagesRead,
+			FinishedBook: req.FinishedBook,
 		})
 		if err != nil {
 			c.Error(err)
@@ -54,8 +57,13 @@ func LogReading(handler *appReading.LogReadingHandler) gin.HandlerFunc {
 		}
 
 		response.JSON(c, dto.LogReadingResponse{
-			NewStreak:          newStreak,
-			TotalMinutesLogged: totalMinutes,
+			NewStreak:            res.NewStreak,
+			TotalMinutesLogged:   res.TotalMinutes,
+			StreakFreezes:        &res.StreakFreezes,
+			StreakFreezeUsed:     res.StreakFreezeUsed,
+			StreakFreezeEarned:   res.StreakFreezeEarned,
+			AchievementsUnlocked: dto.AchievementsToDTO(res.Achievements),
+			LevelUps:             dto.LevelUpsToDTO(res.LevelUps),
 		})
 	}
 }
@@ -3,6 +3,7 @@ package handlers
 import (
 	"github.com/gin-gonic/gin"
 
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/dto"
 	"github.com/bakhtybayevn/powerbook/internal/adapters/http/middleware"
 	"github.com/bakhtybayevn/powerbook/internal/adapters/http/response"
 	"github.com/bakhtybayevn/powerbook/internal/core"
@@ -16,7 +17,7 @@ import (
 // @Produce json
 // @Success 200 {object} map[string]interface{}
 // @Router /reading/history [get]
-func ReadingHistory(repo ports.ReadingRepository) gin.HandlerFunc {
+func ReadingHistory(repo ports.ReadingRepository, bookRepo ports.BookRepository) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		userID := middleware.GetUserID(c)
 
@@ -27,19 +28,37 @@ func ReadingHistory(repo ports.ReadingRepository) gin.HandlerFunc {
 		}
 
 		type entry struct {
-			ID        string `json:"id"`
-			Minutes   int    `json:"minutes"`
-			Source    string `json:"source"`
-			Timestamp string `json:"timestamp"`
+			ID        string       `json:"id"`
+			Minutes   int          `json:"minutes"`
+			Source    string       `json:"source"`
+			Timestamp string       `json:"timestamp"`
+			PagesRead int          `json:"pages_read"`
+			Book      *dto.BookDTO `json:"book,omitempty"`
 		}
 
+		// cache books so a long history of the same title hits the DB once
+		books := map[string]*dto.BookDTO{}
+
 		entries := make([]entry, 0, len(logs))
 		for _, l := range logs {
+			var bk *dto.BookDTO
+			if l.BookID != "" {
+				if cached, ok := books[l.BookID]; ok {
+					bk = cached
+				} else if b, err := bookRepo.Get(l.BookID); err == nil {
+					d := dto.BookToDTO(b)
+					bk = &d
+					books[l.BookID] = bk
+				}
+			}
+
 			entries = append(entries, entry{
 				ID:        l.ID,
 				Minutes:   l.Minutes,
 				Source:    l.Source,
 				Timestamp: l.Timestamp.Format("2006-01-02T15:04:05Z"),
+				PagesRead: l.PagesRead,
+				Book:      bk,
 			})
 		}
 
@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/middleware"
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/response"
+	appUser "github.com/bakhtybayevn/powerbook/internal/application/user"
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/user"
+)
+
+// BuyStreakFreeze godoc
+// @Summary Buy a streak freeze with XP
+// @Tags users
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} map[string]interface{}
+// @Router /users/me/streak-freezes [post]
+func BuyStreakFreeze(handler *appUser.BuyStreakFreezeHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := middleware.GetUserID(c)
+		if userID == "" {
+			c.Error(core.New(core.AuthError, "unauthorized"))
+			return
+		}
+
+		u, err := handler.Handle(appUser.BuyStreakFreezeCommand{UserID: userID})
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		response.JSON(c, gin.H{
+			"streak_freezes": u.StreakFreezes,
+			"xp":             u.XP,
+			"xp_cost":        user.StreakFreezeXPCost,
+		})
+	}
+}
+
+func streakFreezeHistoryToJSON(events []*user.StreakFreezeEvent) []gin.H {
+	out := make([]gin.H, 0, len(events))
+	for _, e := range events {
+		entry := gin.H{
+			"kind":       string(e.Kind),
+			"created_at": e.CreatedAt.Format(time.RFC3339),
+		}
+		if e.Day != nil {
+			entry["day"] = e.Day.Format("2006-01-02")
+		}
+		if e.XPCost > 0 {
+			entry["xp_cost"] = e.XPCost
+		}
+		out = append(out, entry)
+	}
+	return out
+}
@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/middleware"
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/response"
+	appTelegram "github.com/bakhtybayevn/powerbook/internal/application/telegram"
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// CreateTelegramLinkCode godoc
+// @Summary Get a one-time code to link a Telegram chat
+// @Description Send "/link CODE" to the bot within 10 minutes to connect the chat to your account
+// @Tags users
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} map[string]interface{}
+// @Router /users/me/telegram/link [post]
+func CreateTelegramLinkCode(handler *appTelegram.IssueLinkCodeHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := middleware.GetUserID(c)
+		if userID == "" {
+			c.Error(core.New(core.AuthError, "unauthorized"))
+			return
+		}
+
+		code, err := handler.Handle(userID)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		response.JSON(c, gin.H{
+			"code":       code.Code,
+			"command":    "/link " + code.Code,
+			"expires_at": code.ExpiresAt.Format(time.RFC3339),
+		})
+	}
+}
+
+// GetTelegramLink godoc
+// @Summary Show whether a Telegram chat is linked
+// @Tags users
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} map[string]interface{}
+// @Router /users/me/telegram [get]
+func GetTelegramLink(links ports.TelegramLinkRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := middleware.GetUserID(c)
+		if userID == "" {
+			c.Error(core.New(core.AuthError, "unauthorized"))
+			return
+		}
+
+		chatID, err := links.ChatID(userID)
+		if err != nil {
+			c.Error(core.New(core.ServerError, "failed to load telegram link"))
+			return
+		}
+
+		response.JSON(c, gin.H{"linked": chatID != 0})
+	}
+}
+
+// UnlinkTelegram godoc
+// @Summary Disconnect the linked Telegram chat
+// @Tags users
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} map[string]interface{}
+// @Router /users/me/telegram [delete]
+func UnlinkTelegram(links ports.TelegramLinkRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := middleware.GetUserID(c)
+		if userID == "" {
+			c.Error(core.New(core.AuthError, "unauthorized"))
+			return
+		}
+
+		if err := links.Unlink(userID); err != nil {
+			c.Error(core.New(core.ServerError, "failed to unlink telegram"))
+			return
+		}
+
+		response.JSON(c, gin.H{"linked": false})
+	}
+}
@@ -3,8 +3,10 @@ package handlers
 import (
 	"github.com/gin-gonic/gin"
 
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/dto"
 	"github.com/bakhtybayevn/powerbook/internal/adapters/http/middleware"
 	"github.com/bakhtybayevn/powerbook/internal/adapters/http/response"
+	appXP "github.com/bakhtybayevn/powerbook/internal/application/xp"
 	"github.com/bakhtybayevn/powerbook/internal/core"
 	"github.com/bakhtybayevn/powerbook/internal/ports"
 )
@@ -16,7 +18,7 @@ import (
 // @Param id path string true "User ID"
 // @Success 200 {object} map[string]interface{}
 // @Router /users/{id} [get]
-func GetUserProfile(repo ports.UserRepository) gin.HandlerFunc {
+func GetUserProfile(repo ports.UserRepository, policies ports.XPPolicyRepository, achievements ports.AchievementRepository, perks *appXP.PerksReader) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		userID := c.Param("id")
 		if userID == "" {
@@ -30,21 +32,26 @@ func GetUserProfile(repo ports.UserRepository) gin.HandlerFunc {
 			return
 		}
 
+		level, levelName := currentPolicy(policies).Level(u.XP)
+		badges, _ := achievements.ListByUser(u.ID)
+		userPerks, _ := perks.For(u)
 		response.JSON(c, gin.H{
 			"id":              u.ID,
 			"display_name":    u.DisplayName,
 			"streak_current":  u.StreakCurrentDays,
 			"total_minutes":   u.TotalMinutes,
 			"xp":              u.XP,
-			"level":           u.Level(),
-			"level_name":      u.LevelName(),
+			"level":           level,
+			"level_name":      levelName,
+			"flair":           userPerks.Flair,
 			"telegram_handle": u.TelegramHandle,
+			"badges":          dto.BadgesToDTO(badges),
 		})
 	}
 }
 
 // UpdateProfile godoc
-// @Summary Update current user's profile (telegram handle)
+// @Summary Update current user's profile (telegram handle, timezone)
 // @Tags users
 // @Security BearerAuth
 // @Accept json
@@ -59,8 +66,10 @@ func UpdateProfile(repo ports.UserRepository) gin.HandlerFunc {
 			return
 		}
 
+		// omitted fields are left unchanged
 		var req struct {
-			TelegramHandle string `json:"telegram_handle"`
+			TelegramHandle *string `json:"telegram_handle"`
+			Timezone       *string `json:"timezone"`
 		}
 		if err := c.ShouldBindJSON(&req); err != nil {
 			c.Error(core.New(core.ValidationError, "invalid request body"))
@@ -73,8 +82,16 @@ func UpdateProfile(repo ports.UserRepository) gin.HandlerFunc {
 			return
 		}
 
-		u.TelegramHandle = req.TelegramHandle
-		if err := repo.Save(u); err != nil {
+		if req.TelegramHandle != nil {
+			u.TelegramHandle = *req.TelegramHandle
+		}
+		if req.Timezone != nil {
+			if err := u.SetTimezone(*req.Timezone); err != nil {
+				c.Error(core.New(core.ValidationError, err.Error()))
+				return
+			}
+		}
+		if err := repo.UpdateProfile(u); err != nil {
 			c.Error(err)
 			return
 		}
@@ -83,6 +100,7 @@ func UpdateProfile(repo ports.UserRepository) gin.HandlerFunc {
 			"id":              u.ID,
 			"display_name":    u.DisplayName,
 			"telegram_handle": u.TelegramHandle,
+			"timezone":        u.Timezone,
 		})
 	}
 }
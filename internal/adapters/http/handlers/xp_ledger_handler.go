@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/dto"
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/middleware"
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/response"
+	appXP "github.com/bakhtybayevn/powerbook/internal/application/xp"
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// GetMyXPHistory godoc
+// @Summary Every XP change of the authenticated user, newest first
+// @Tags users
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {array} dto.XPTransactionDTO
+// @Router /users/me/xp-history [get]
+func GetMyXPHistory(ledger ports.XPLedgerRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := middleware.GetUserID(c)
+		if userID == "" {
+			c.Error(core.New(core.AuthError, "unauthorized"))
+			return
+		}
+
+		list, err := ledger.ListByUser(userID)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+		response.JSON(c, dto.XPTransactionsToDTO(list))
+	}
+}
+
+func recomputeResultToJSON(r *appXP.RecomputeXPResult) gin.H {
+	return gin.H{
+		"user_id":     r.UserID,
+		"previous_xp": r.PreviousXP,
+		"xp":          r.XP,
+	}
+}
+
+// AdminRecomputeUserXP godoc
+// @Summary Rebuild a user's XP from their XP ledger (admin only)
+// @Tags admin
+// @Security BearerAuth
+// @Produce json
+// @Param id path string true "User ID"
+// @Success 200 {object} map[string]interface{}
+// @Router /admin/users/{id}/xp/recompute [post]
+func AdminRecomputeUserXP(userRepo ports.UserRepository, handler *appXP.RecomputeXPHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !requireAdmin(c, userRepo) {
+			return
+		}
+
+		res, err := handler.Handle(appXP.RecomputeXPCommand{UserID: c.Param("id")})
+		if err != nil {
+			c.Error(err)
+			return
+		}
+		response.JSON(c, recomputeResultToJSON(res))
+	}
+}
+
+// AdminRecomputeAllXP godoc
+// @Summary Rebuild every user's XP from the XP ledger and list the balances that changed (admin only)
+// @Tags admin
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} map[string]interface{}
+// @Router /admin/xp/recompute [post]
+func AdminRecomputeAllXP(userRepo ports.UserRepository, handler *appXP.RecomputeXPHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !requireAdmin(c, userRepo) {
+			return
+		}
+
+		changed, err := handler.HandleAll()
+		if err != nil {
+			c.Error(err)
+			return
+		}
+		out := make([]gin.H, 0, len(changed))
+		for _, r := range changed {
+			out = append(out, recomputeResultToJSON(r))
+		}
+		response.JSON(c, gin.H{"changed": out})
+	}
+}
@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/dto"
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/middleware"
+	"github.com/bakhtybayevn/powerbook/internal/adapters/http/response"
+	appXP "github.com/bakhtybayevn/powerbook/internal/application/xp"
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/xp"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// currentPolicy is the XP policy in force, or the default one if it can't
+// be loaded; used to show levels.
+func currentPolicy(policies ports.XPPolicyRepository) xp.Policy {
+	p, err := policies.Current()
+	if err != nil {
+		return xp.DefaultPolicy()
+	}
+	return *p
+}
+
+// AdminGetXPPolicy godoc
+// @Summary Current XP policy: competition rewards and level thresholds (admin only)
+// @Tags admin
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} dto.XPPolicyDTO
+// @Router /admin/xp-policy [get]
+func AdminGetXPPolicy(userRepo ports.UserRepository, policies ports.XPPolicyRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !requireAdmin(c, userRepo) {
+			return
+		}
+		p, err := policies.Current()
+		if err != nil {
+			c.Error(err)
+			return
+		}
+		response.JSON(c, dto.XPPolicyToDTO(p))
+	}
+}
+
+// AdminListXPPolicies godoc
+// @Summary Every XP policy version, newest first (admin only)
+// @Tags admin
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {array} dto.XPPolicyDTO
+// @Router /admin/xp-policy/versions [get]
+func AdminListXPPolicies(userRepo ports.UserRepository, policies ports.XPPolicyRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !requireAdmin(c, userRepo) {
+			return
+		}
+		list, err := policies.List()
+		if err != nil {
+			c.Error(err)
+			return
+		}
+		response.JSON(c, dto.XPPoliciesToDTO(list))
+	}
+}
+
+// AdminUpdateXPPolicy godoc
+// @Summary Change the XP policy; creates a new version used by competitions closed from now on (admin only)
+// @Tags admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body dto.UpdateXPPolicyRequest true "Fields to change"
+// @Success 200 {object} dto.XPPolicyDTO
+// @Router /admin/xp-policy [put]
+func AdminUpdateXPPolicy(userRepo ports.UserRepository, handler *appXP.UpdatePolicyHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !requireAdmin(c, userRepo) {
+			return
+		}
+
+		var req dto.UpdateXPPolicyRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.Error(core.New(core.ValidationError, "invalid xp policy request"))
+			return
+		}
+
+		cmd := appXP.UpdatePolicyCommand{
+			ActorID:         middleware.GetUserID(c),
+			FirstPlaceXP:    req.FirstPlaceXP,
+			SecondPlaceXP:   req.SecondPlaceXP,
+			ThirdPlaceXP:    req.ThirdPlaceXP,
+			TopHalfXP:       req.TopHalfXP,
+			BottomHalfXP:    req.BottomHalfXP,
+			PerDayXP:        req.PerDayXP,
+			PerfectStreakXP: req.PerfectStreakXP,
+
+			SeasonFirstXP:      req.SeasonFirstXP,
+			SeasonSecondXP:     req.SeasonSecondXP,
+			SeasonThirdXP:      req.SeasonThirdXP,
+			SeasonTopHalfXP:    req.SeasonTopHalfXP,
+			SeasonBottomHalfXP: req.SeasonBottomHalfXP,
+			SeasonWinXP:        req.SeasonWinXP,
+		}
+		if req.Levels != nil {
+			cmd.Levels = make([]xp.Level, 0, len(req.Levels))
+			for _, l := range req.Levels {
+				cmd.Levels = append(cmd.Levels, xp.Level{Name: l.Name, XP: l.XP})
+			}
+		}
+
+		p, err := handler.Handle(cmd)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+		response.JSON(c, dto.XPPolicyToDTO(p))
+	}
+}
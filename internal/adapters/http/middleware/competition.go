@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+	"github.com/gin-gonic/gin"
+)
+
+// CompetitionVisible answers 404 for a competition the caller may not see,
+// the same as for one that doesn't exist. It goes after the (optional) auth
+// middleware on every route that reads a competition by :id.
+func CompetitionVisible(competitions ports.CompetitionRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		comp, err := competitions.Get(c.Param("id"))
+		if err != nil {
+			c.Error(err)
+			c.Abort()
+			return
+		}
+		if !comp.VisibleTo(GetUserID(c)) {
+			c.Error(core.New(core.NotFoundError, "competition not found"))
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
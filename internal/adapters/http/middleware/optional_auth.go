@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"strings"
+
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+	"github.com/gin-gonic/gin"
+)
+
+// OptionalAuthMiddleware identifies the caller when a valid bearer token is
+// sent, but lets anonymous requests through. Used by public endpoints whose
+// output depends on who is asking.
+func OptionalAuthMiddleware(auth ports.AuthService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		parts := strings.Split(c.GetHeader("Authorization"), " ")
+		if len(parts) == 2 && parts[0] == "Bearer" {
+			if userID, err := auth.ParseToken(parts[1]); err == nil {
+				c.Set("userID", userID)
+			}
+		}
+		c.Next()
+	}
+}
@@ -1,23 +1,27 @@
 package http
 
 import (
-	"database/sql"
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 	"time"
 
-	_ "github.com/lib/pq"
-
+	"github.com/bakhtybayevn/powerbook/internal/adapters/email"
 	"github.com/bakhtybayevn/powerbook/internal/adapters/http/handlers"
 	"github.com/bakhtybayevn/powerbook/internal/adapters/http/middleware"
-	jwtToken "github.com/bakhtybayevn/powerbook/internal/adapters/http/token"
-	postgres "github.com/bakhtybayevn/powerbook/internal/adapters/postgres"
-	"github.com/bakhtybayevn/powerbook/internal/adapters/redis"
+	"github.com/bakhtybayevn/powerbook/internal/adapters/leaderboard"
+	"github.com/bakhtybayevn/powerbook/internal/adapters/telegram"
+	appAchievement "github.com/bakhtybayevn/powerbook/internal/application/achievement"
+	appBook "github.com/bakhtybayevn/powerbook/internal/application/book"
 	appCompetition "github.com/bakhtybayevn/powerbook/internal/application/competition"
+	appEmail "github.com/bakhtybayevn/powerbook/internal/application/email"
+	appNotification "github.com/bakhtybayevn/powerbook/internal/application/notification"
 	appReading "github.com/bakhtybayevn/powerbook/internal/application/reading"
+	appTelegram "github.com/bakhtybayevn/powerbook/internal/application/telegram"
 	appUser "github.com/bakhtybayevn/powerbook/internal/application/user"
+	appXP "github.com/bakhtybayevn/powerbook/internal/application/xp"
 	"github.com/bakhtybayevn/powerbook/internal/config"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
 
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
@@ -49,65 +53,181 @@ func (s *Server) RegisterRoutes() {
 		c.JSON(http.StatusOK, gin.H{"status": "ok"})
 	})
 
-	db, err := sql.Open("postgres", s.cfg.PostgresDSN())
-	if err != nil {
-		log.Fatalf("failed to connect to DB: %v", err)
-	}
+	store := openStorage(s.cfg)
+
+	userRepo := store.users
+	tokenService := store.tokens
+	readingRepo := store.readings
+	competitionRepo := store.competitions
+	bookRepo := store.books
+	freezeRepo := store.freezes
+	seriesRepo := store.series
+	xpPolicyRepo := store.xpPolicies
+	xpLedgerRepo := store.xpLedger
+	achievementRepo := store.achievements
+	perksReader := appXP.NewPerksReader(xpPolicyRepo, store.levelUps)
+	lb := store.leaderboard
+	lbHealth := middleware.RedisHealth(lb)
+	telegramLinks := store.telegram
+
+	inbox := store.inbox
 
-	redisAddr := fmt.Sprintf("%s:%d", s.cfg.Redis.Host, s.cfg.Redis.Port)
+	// notifications always reach the in-app inbox; the Telegram bot and its
+	// channel are off unless a token is configured
+	var (
+		telegramClient *telegram.Client
+		channels       []ports.NotificationChannel
+	)
+	if s.cfg.Telegram.BotToken != "" {
+		telegramClient = telegram.NewClient(s.cfg.Telegram.APIURL, s.cfg.Telegram.BotToken)
+		channels = append(channels, appTelegram.NewChannel(telegramClient, telegramLinks))
+	}
 
-	userRepo := postgres.NewPostgresUserRepo(db)
-	tokenService := jwtToken.NewJWTService(s.cfg.JWT.Secret)
-	readingRepo := postgres.NewPostgresReadingRepo(db)
-	competitionRepo := postgres.NewPostgresCompetitionRepo(db)
-	redisLB := redis.NewRedisLeaderboard(redisAddr, s.cfg.Redis.Password, s.cfg.Redis.UseTLS)
-	lbHealth := middleware.RedisHealth(redisLB)
+	// email is off unless an SMTP host is configured; mail waits in the
+	// outbox and a relay sends it
+	var (
+		mailer     *appEmail.Mailer
+		emailRelay *appEmail.Relay
+	)
+	if s.cfg.SMTP.Host != "" {
+		smtp := s.cfg.SMTP
+		sender, err := email.NewSMTPSender(smtp.Host, smtp.Port, smtp.Username, smtp.Password, smtp.From)
+		if err != nil {
+			log.Fatalf("failed to configure email: %v", err)
+		}
+		mailer = appEmail.NewMailer(store.emailOutbox)
+		emailRelay = appEmail.NewRelay(store.emailOutbox, sender)
+		channels = append(channels, appEmail.NewChannel(userRepo, mailer))
+	}
+	notifier := appNotification.NewDispatcher(inbox, store.notifyPrefs, channels...)
 
 	// === HANDLERS ===
-	leaderboardHandler := handlers.NewLeaderboardHandler(redisLB, userRepo)
+	leaderboardHandler := handlers.NewLeaderboardHandler(lb, userRepo, competitionRepo)
 
 	// === USE CASES ===
 	registerUserHandler := appUser.NewRegisterUserHandler(userRepo)
 	loginUserHandler := appUser.NewLoginUserHandler(userRepo, tokenService)
-	logReadingHandler := appReading.NewLogReadingHandler(userRepo, readingRepo, competitionRepo, redisLB)
-	createCompetitionHandler := appCompetition.NewCreateCompetitionHandler(competitionRepo)
-	joinCompetitionHandler := appCompetition.NewJoinCompetitionHandler(competitionRepo)
-	closeCompetitionHandler := appCompetition.NewCloseCompetitionHandler(competitionRepo, userRepo)
+	changePasswordHandler := appUser.NewChangePasswordHandler(userRepo, tokenService)
+	requestPasswordResetHandler := appUser.NewRequestPasswordResetHandler(userRepo, store.uow, mailer, s.cfg.App.PasswordResetURL)
+	resetPasswordHandler := appUser.NewResetPasswordHandler(userRepo, store.resets)
+	buyStreakFreezeHandler := appUser.NewBuyStreakFreezeHandler(store.uow)
+	logReadingHandler := appReading.NewLogReadingHandler(store.uow, bookRepo, lb, notifier)
+	createCompetitionHandler := appCompetition.NewCreateCompetitionHandler(competitionRepo, userRepo, perksReader)
+	joinCompetitionHandler := appCompetition.NewJoinCompetitionHandler(store.uow)
+	closeCompetitionHandler := appCompetition.NewCloseCompetitionHandler(store.uow, xpPolicyRepo, notifier)
+	createTeamHandler := appCompetition.NewCreateTeamHandler(store.uow, lb)
+	listJoinRequestsHandler := appCompetition.NewListJoinRequestsHandler(competitionRepo, userRepo)
+	decideJoinRequestHandler := appCompetition.NewDecideJoinRequestHandler(store.uow, userRepo)
+	regenerateInviteCodeHandler := appCompetition.NewRegenerateInviteCodeHandler(store.uow, userRepo)
+	addOrganizerHandler := appCompetition.NewAddOrganizerHandler(competitionRepo, userRepo)
+	removeOrganizerHandler := appCompetition.NewRemoveOrganizerHandler(competitionRepo, userRepo)
+	deleteCompetitionHandler := appCompetition.NewDeleteCompetitionHandler(competitionRepo, userRepo, lb)
+	updateCompetitionHandler := appCompetition.NewUpdateCompetitionHandler(store.uow, userRepo, perksReader)
+	cancelCompetitionHandler := appCompetition.NewCancelCompetitionHandler(competitionRepo, userRepo)
+	leaveCompetitionHandler := appCompetition.NewLeaveCompetitionHandler(store.uow, lb)
+	kickParticipantHandler := appCompetition.NewKickParticipantHandler(store.uow, userRepo, lb)
+	createSeriesHandler := appCompetition.NewCreateSeriesHandler(seriesRepo, userRepo, perksReader)
+	stopSeriesHandler := appCompetition.NewStopSeriesHandler(seriesRepo, userRepo)
+	spawnSeriesHandler := appCompetition.NewSpawnSeriesInstancesHandler(seriesRepo, store.uow)
+	createSeasonHandler := appCompetition.NewCreateSeasonHandler(seriesRepo, userRepo)
+	seasonStandingsHandler := appCompetition.NewSeasonStandingsHandler(seriesRepo, competitionRepo)
+	closeSeasonsHandler := appCompetition.NewCloseSeasonsHandler(seriesRepo, competitionRepo, xpPolicyRepo, store.uow)
+	leaderboardReconciler := appCompetition.NewLeaderboardReconciler(competitionRepo, store.primaryBoard)
+	competitionResultsHandler := appCompetition.NewCompetitionResultsHandler(competitionRepo)
 	listAllCompetitionsHandler := appCompetition.NewListAllCompetitionsHandler(competitionRepo, userRepo)
 	listMyCompetitionsHandler := appCompetition.NewListMyCompetitionsHandler(competitionRepo, userRepo)
+	updateXPPolicyHandler := appXP.NewUpdatePolicyHandler(xpPolicyRepo)
+	recomputeXPHandler := appXP.NewRecomputeXPHandler(store.uow, userRepo)
+	achievementEvaluator := appAchievement.NewEvaluator(store.uow)
+	confirmGiftHandler := appCompetition.NewConfirmGiftHandler(competitionRepo, userRepo, achievementEvaluator, notifier)
+	notifyStartedHandler := appCompetition.NewNotifyStartedHandler(competitionRepo, notifier)
+	addBookHandler := appBook.NewAddBookHandler(bookRepo)
+	statsRecalculator := appReading.NewStatsRecalculator(store.uow, lb)
+	updateReadingHandler := appReading.NewUpdateReadingHandler(bookRepo, statsRecalculator)
+	deleteReadingHandler := appReading.NewDeleteReadingHandler(statsRecalculator)
+	issueTelegramLinkCodeHandler := appTelegram.NewIssueLinkCodeHandler(telegramLinks)
 
 	// === API VERSIONING (/api/v1) ===
 	v1 := s.router.Group("/api/v1")
 
 	// ---- Public endpoints ----
+	// optional auth lets listings include the caller's private competitions
+	optionalAuth := middleware.OptionalAuthMiddleware(tokenService)
+	visible := middleware.CompetitionVisible(competitionRepo)
 	v1.POST("/users/register", handlers.RegisterUser(registerUserHandler))
 	v1.POST("/users/login", handlers.LoginUser(loginUserHandler))
-	v1.GET("/users/:id", handlers.GetUserProfile(userRepo))
-	v1.GET("/competitions", handlers.ListAllCompetitions(listAllCompetitionsHandler))
-	v1.GET("/competitions/:id", handlers.GetCompetition(competitionRepo, userRepo))
-	v1.GET("/competitions/:id/leaderboard", lbHealth, leaderboardHandler.GetLeaderboard)
-	v1.GET("/competitions/:id/rank/:userID", lbHealth, leaderboardHandler.GetRank)
-	v1.GET("/competitions/:id/gifts", handlers.GetGiftExchanges(competitionRepo, userRepo))
+	v1.POST("/users/password/forgot", handlers.ForgotPassword(requestPasswordResetHandler))
+	v1.POST("/users/password/reset", handlers.ResetPassword(resetPasswordHandler))
+	v1.GET("/users/:id", handlers.GetUserProfile(userRepo, xpPolicyRepo, achievementRepo, perksReader))
+	v1.GET("/competitions", optionalAuth, handlers.ListAllCompetitions(listAllCompetitionsHandler))
+	v1.GET("/competitions/:id", optionalAuth, handlers.GetCompetition(competitionRepo, userRepo))
+	v1.GET("/competitions/invite/:code", handlers.GetCompetitionByInvite(competitionRepo, userRepo))
+	v1.GET("/competitions/:id/leaderboard", optionalAuth, visible, lbHealth, leaderboardHandler.GetLeaderboard)
+	v1.GET("/competitions/:id/leaderboard/teams", optionalAuth, visible, lbHealth, leaderboardHandler.GetTeamLeaderboard)
+	v1.GET("/competitions/:id/teams", optionalAuth, visible, handlers.ListTeams(competitionRepo))
+	v1.GET("/competitions/:id/rank/:userID", optionalAuth, visible, lbHealth, leaderboardHandler.GetRank)
+	v1.GET("/competitions/:id/results", optionalAuth, handlers.GetCompetitionResults(competitionResultsHandler, userRepo))
+	v1.GET("/competitions/:id/gifts", optionalAuth, visible, handlers.GetGiftExchanges(competitionRepo, userRepo))
+	v1.GET("/series/:id", handlers.GetSeries(seriesRepo, competitionRepo, userRepo))
+	v1.GET("/seasons/:id/standings", handlers.GetSeasonStandings(seasonStandingsHandler, userRepo))
+	v1.GET("/achievements", handlers.ListAchievements())
+	v1.GET("/books", handlers.SearchBooks(bookRepo))
+	v1.GET("/books/:id", handlers.GetBook(bookRepo))
 
 	// ---- Protected endpoints ----
 	auth := v1.Group("/")
 	auth.Use(middleware.AuthMiddleware(tokenService))
-	auth.GET("/users/me", handlers.GetMe(userRepo))
+	auth.GET("/users/me", handlers.GetMe(userRepo, freezeRepo, xpPolicyRepo, achievementRepo, perksReader))
 	auth.PUT("/users/me/profile", handlers.UpdateProfile(userRepo))
+	auth.PUT("/users/me/password", handlers.ChangePassword(changePasswordHandler))
+	auth.POST("/users/me/streak-freezes", handlers.BuyStreakFreeze(buyStreakFreezeHandler))
+	auth.GET("/users/me/xp-history", handlers.GetMyXPHistory(xpLedgerRepo))
+	auth.POST("/users/me/telegram/link", handlers.CreateTelegramLinkCode(issueTelegramLinkCodeHandler))
+	auth.GET("/users/me/telegram", handlers.GetTelegramLink(telegramLinks))
+	auth.DELETE("/users/me/telegram", handlers.UnlinkTelegram(telegramLinks))
 	auth.POST("/reading/log", handlers.LogReading(logReadingHandler))
-	auth.GET("/reading/history", handlers.ReadingHistory(readingRepo))
+	auth.PUT("/reading/:id", handlers.UpdateReading(updateReadingHandler))
+	auth.DELETE("/reading/:id", handlers.DeleteReading(deleteReadingHandler))
+	auth.GET("/reading/history", handlers.ReadingHistory(readingRepo, bookRepo))
+	auth.POST("/books", handlers.AddBook(addBookHandler))
 	auth.POST("/competitions/create", handlers.CreateCompetition(createCompetitionHandler))
 	auth.POST("/competitions/:id/join", handlers.JoinCompetition(joinCompetitionHandler))
+	auth.POST("/competitions/:id/leave", handlers.LeaveCompetition(leaveCompetitionHandler))
+	auth.POST("/competitions/:id/participants/:userID/kick", handlers.KickParticipant(kickParticipantHandler))
+	auth.POST("/competitions/:id/teams", handlers.CreateTeam(createTeamHandler))
+	auth.POST("/competitions/:id/invite-code", handlers.RegenerateInviteCode(regenerateInviteCodeHandler))
+	auth.GET("/competitions/:id/join-requests", handlers.ListJoinRequests(listJoinRequestsHandler, userRepo))
+	auth.POST("/competitions/:id/join-requests/:userID/approve", handlers.ApproveJoinRequest(decideJoinRequestHandler))
+	auth.POST("/competitions/:id/join-requests/:userID/reject", handlers.RejectJoinRequest(decideJoinRequestHandler))
 	auth.POST("/competitions/:id/close", handlers.CloseCompetition(closeCompetitionHandler))
-	auth.GET("/competitions/:id/rank/me", lbHealth, leaderboardHandler.GetRankMe)
+	auth.PUT("/competitions/:id", handlers.UpdateCompetition(updateCompetitionHandler))
+	auth.POST("/competitions/:id/cancel", handlers.CancelCompetition(cancelCompetitionHandler))
+	auth.DELETE("/competitions/:id", handlers.DeleteCompetition(deleteCompetitionHandler))
+	auth.POST("/competitions/:id/organizers", handlers.AddOrganizer(addOrganizerHandler))
+	auth.DELETE("/competitions/:id/organizers/:userID", handlers.RemoveOrganizer(removeOrganizerHandler))
+	auth.GET("/competitions/:id/rank/me", visible, lbHealth, leaderboardHandler.GetRankMe)
 	auth.GET("/competitions/my", handlers.ListMyCompetitions(listMyCompetitionsHandler))
-	auth.POST("/gifts/:giftId/confirm", handlers.ConfirmGift(competitionRepo))
+	auth.POST("/gifts/:giftId/confirm", handlers.ConfirmGift(confirmGiftHandler))
+	auth.GET("/notifications", handlers.ListNotifications(inbox))
+	auth.POST("/notifications/read-all", handlers.MarkAllNotificationsRead(inbox))
+	auth.POST("/notifications/:id/read", handlers.MarkNotificationRead(inbox))
+	auth.GET("/notifications/preferences", handlers.GetNotificationPreferences(store.notifyPrefs))
+	auth.PUT("/notifications/preferences", handlers.UpdateNotificationPreferences(store.notifyPrefs))
+	auth.POST("/series", handlers.CreateSeries(createSeriesHandler))
+	auth.POST("/series/:id/stop", handlers.StopSeries(stopSeriesHandler))
+	auth.POST("/series/:id/seasons", handlers.CreateSeason(createSeasonHandler))
 
 	// ---- Admin endpoints (require is_admin) ----
-	auth.GET("/admin/users", handlers.AdminListUsers(userRepo))
+	auth.GET("/admin/users", handlers.AdminListUsers(userRepo, xpPolicyRepo))
 	auth.DELETE("/admin/users/:id", handlers.AdminDeleteUser(userRepo))
+	auth.POST("/admin/competitions/:id/leaderboard/rebuild", handlers.AdminRebuildLeaderboard(userRepo, leaderboardReconciler))
+	auth.GET("/admin/xp-policy", handlers.AdminGetXPPolicy(userRepo, xpPolicyRepo))
+	auth.GET("/admin/xp-policy/versions", handlers.AdminListXPPolicies(userRepo, xpPolicyRepo))
+	auth.PUT("/admin/xp-policy", handlers.AdminUpdateXPPolicy(userRepo, updateXPPolicyHandler))
+	auth.POST("/admin/users/:id/xp/recompute", handlers.AdminRecomputeUserXP(userRepo, recomputeXPHandler))
+	auth.POST("/admin/xp/recompute", handlers.AdminRecomputeAllXP(userRepo, recomputeXPHandler))
 
-	// === AUTO-CLOSE SCHEDULER ===
+	// === AUTO-CLOSE & SERIES SCHEDULER ===
 	go func() {
 		ticker := time.NewTicker(5 * time.Minute)
 		defer ticker.Stop()
@@ -126,6 +246,78 @@ func (s *Server) RegisterRoutes() {
 					})
 				}
 			}
+
+			if err := notifyStartedHandler.Handle(now); err != nil {
+				log.Printf("[Notifications] failed to announce started competitions: %v", err)
+			}
+
+			// next instances of recurring series, then seasons whose
+			// competitions have all finished
+			if n, err := spawnSeriesHandler.Handle(now); err != nil {
+				log.Printf("[Series] failed to spawn instances: %v", err)
+			} else if n > 0 {
+				log.Printf("[Series] created %d competition(s)", n)
+			}
+			if n, err := closeSeasonsHandler.Handle(now); err != nil {
+				log.Printf("[Seasons] failed to close seasons: %v", err)
+			} else if n > 0 {
+				log.Printf("[Seasons] closed %d season(s)", n)
+			}
+		}
+	}()
+
+	// === TELEGRAM BOT ===
+	if telegramClient != nil {
+		bot := appTelegram.NewBot(telegramClient, telegramLinks, userRepo, competitionRepo, lb, logReadingHandler)
+		go bot.Run(context.Background())
+	}
+
+	// === EMAIL RELAY & WEEKLY SUMMARIES ===
+	if emailRelay != nil {
+		weeklySummaryHandler := appEmail.NewWeeklySummaryHandler(userRepo, readingRepo, store.notifyPrefs, mailer)
+		go func() {
+			ticker := time.NewTicker(time.Minute)
+			defer ticker.Stop()
+			for range ticker.C {
+				now := time.Now().UTC()
+				if n, err := weeklySummaryHandler.Handle(now); err != nil {
+					log.Printf("[Email] failed to queue weekly summaries: %v", err)
+				} else if n > 0 {
+					log.Printf("[Email] queued %d weekly summaries", n)
+				}
+				if _, err := emailRelay.Handle(now); err != nil {
+					log.Printf("[Email] relay failed: %v", err)
+				}
+			}
+		}()
+	}
+
+	// === LEADERBOARD DRIFT CHECK ===
+	// right away when Redis comes back after an outage, then every 10 minutes
+	if fb, ok := store.leaderboard.(*leaderboard.FallbackLeaderboard); ok {
+		fb.OnRecover(func() error {
+			n, err := leaderboardReconciler.CheckOpen()
+			if n > 0 {
+				log.Printf("[Leaderboard] rebuilt %d leaderboard(s) after the outage", n)
+			}
+			return err
+		})
+	}
+	go func() {
+		ticker := time.NewTicker(10 * time.Minute)
+		defer ticker.Stop()
+		for range ticker.C {
+			if err := store.primaryBoard.Ping(context.Background()); err != nil {
+				continue // nothing to reconcile against until the board is back
+			}
+			n, err := leaderboardReconciler.CheckOpen()
+			if err != nil {
+				log.Printf("[Leaderboard] drift check failed: %v", err)
+				continue
+			}
+			if n > 0 {
+				log.Printf("[Leaderboard] rebuilt %d drifted leaderboard(s)", n)
+			}
 		}
 	}()
 }
@@ -0,0 +1,134 @@
+package http
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+
+	_ "github.com/lib/pq"
+
+	jwtToken "github.com/bakhtybayevn/powerbook/internal/adapters/http/token"
+	"github.com/bakhtybayevn/powerbook/internal/adapters/leaderboard"
+	"github.com/bakhtybayevn/powerbook/internal/adapters/memory"
+	postgres "github.com/bakhtybayevn/powerbook/internal/adapters/postgres"
+	"github.com/bakhtybayevn/powerbook/internal/adapters/redis"
+	"github.com/bakhtybayevn/powerbook/internal/config"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// tokenIssuer both issues and verifies access tokens.
+type tokenIssuer interface {
+	ports.TokenService
+	ports.AuthService
+}
+
+// storage holds the adapters the API runs on.
+type storage struct {
+	users        ports.UserRepository
+	readings     ports.ReadingRepository
+	competitions ports.CompetitionRepository
+	books        ports.BookRepository
+	freezes      ports.StreakFreezeRepository
+	series       ports.SeriesRepository
+	xpPolicies   ports.XPPolicyRepository
+	xpLedger     ports.XPLedgerRepository
+	achievements ports.AchievementRepository
+	levelUps     ports.LevelUpRepository
+	telegram     ports.TelegramLinkRepository
+	inbox        ports.NotificationRepository
+	notifyPrefs  ports.NotificationPreferenceRepository
+	emailOutbox  ports.EmailOutboxRepository
+	resets       ports.PasswordResetRepository
+	tokens       tokenIssuer
+	uow          ports.UnitOfWork
+
+	// leaderboard serves the handlers; primaryBoard is the board the
+	// reconciler rebuilds and the drift check pings.
+	leaderboard  leaderboard.Board
+	primaryBoard leaderboard.Board
+}
+
+func openStorage(cfg *config.Config) *storage {
+	if cfg.App.Storage == config.StorageMemory {
+		log.Println("storage: in-memory, data is lost on restart")
+		return memoryStorage()
+	}
+	return postgresStorage(cfg)
+}
+
+// postgresStorage is the production setup: Postgres, with leaderboards in
+// Redis and Postgres taking over while Redis is unreachable.
+func postgresStorage(cfg *config.Config) *storage {
+	db, err := sql.Open("postgres", cfg.PostgresDSN())
+	if err != nil {
+		log.Fatalf("failed to connect to DB: %v", err)
+	}
+
+	redisAddr := fmt.Sprintf("%s:%d", cfg.Redis.Host, cfg.Redis.Port)
+	redisLB := redis.NewRedisLeaderboard(redisAddr, cfg.Redis.Password, cfg.Redis.UseTLS)
+
+	users := postgres.NewPostgresUserRepo(db)
+
+	return &storage{
+		users:        users,
+		readings:     postgres.NewPostgresReadingRepo(db),
+		competitions: postgres.NewPostgresCompetitionRepo(db),
+		books:        postgres.NewPostgresBookRepo(db),
+		freezes:      postgres.NewPostgresStreakFreezeRepo(db),
+		series:       postgres.NewPostgresSeriesRepo(db),
+		xpPolicies:   postgres.NewPostgresXPPolicyRepo(db),
+		xpLedger:     postgres.NewPostgresXPLedgerRepo(db),
+		achievements: postgres.NewPostgresAchievementRepo(db),
+		levelUps:     postgres.NewPostgresLevelUpRepo(db),
+		telegram:     postgres.NewPostgresTelegramLinkRepo(db),
+		inbox:        postgres.NewPostgresNotificationRepo(db),
+		notifyPrefs:  postgres.NewPostgresNotificationPreferenceRepo(db),
+		emailOutbox:  postgres.NewPostgresEmailOutboxRepo(db),
+		resets:       postgres.NewPostgresPasswordResetRepo(db),
+		tokens:       jwtToken.NewJWTService(cfg.JWT.Secret, users),
+		uow:          postgres.NewPostgresUnitOfWork(db),
+		leaderboard:  leaderboard.NewFallbackLeaderboard(redisLB, postgres.NewPostgresLeaderboard(db)),
+		primaryBoard: redisLB,
+	}
+}
+
+// memoryStorage runs the whole API without Postgres or Redis, for local
+// development and tests.
+func memoryStorage() *storage {
+	lb := memory.NewLeaderboard()
+	repos := ports.Repositories{
+		Users:        memory.NewUserRepo(),
+		Readings:     memory.NewReadingRepo(),
+		Competitions: memory.NewCompetitionRepo(),
+		Freezes:      memory.NewStreakFreezeRepo(),
+		XPLedger:     memory.NewXPLedgerRepo(),
+		Achievements: memory.NewAchievementRepo(),
+		XPPolicies:   memory.NewXPPolicyRepo(),
+		LevelUps:     memory.NewLevelUpRepo(),
+		Series:       memory.NewSeriesRepo(),
+		Resets:       memory.NewPasswordResetRepo(),
+		EmailOutbox:  memory.NewEmailOutboxRepo(),
+	}
+
+	return &storage{
+		users:        repos.Users,
+		readings:     repos.Readings,
+		competitions: repos.Competitions,
+		books:        memory.NewBookRepo(),
+		freezes:      repos.Freezes,
+		series:       repos.Series,
+		xpPolicies:   repos.XPPolicies,
+		xpLedger:     repos.XPLedger,
+		achievements: repos.Achievements,
+		levelUps:     repos.LevelUps,
+		telegram:     memory.NewTelegramLinkRepo(),
+		inbox:        memory.NewNotificationRepo(),
+		notifyPrefs:  memory.NewNotificationPreferenceRepo(),
+		emailOutbox:  repos.EmailOutbox,
+		resets:       repos.Resets,
+		tokens:       memory.NewTokenService(repos.Users),
+		uow:          memory.NewUnitOfWork(repos),
+		leaderboard:  lb,
+		primaryBoard: lb,
+	}
+}
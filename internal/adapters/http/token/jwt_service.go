@@ -5,20 +5,32 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/bakhtybayevn/powerbook/internal/ports"
 )
 
 type JWTService struct {
 	secret []byte
+	users  ports.UserRepository
 }
 
-func NewJWTService(secret string) *JWTService {
-	return &JWTService{secret: []byte(secret)}
+// NewJWTService signs tokens with secret. Each token carries the user's
+// session version, and users is consulted to reject tokens issued before
+// the last password change.
+func NewJWTService(secret string, users ports.UserRepository) *JWTService {
+	return &JWTService{secret: []byte(secret), users: users}
 }
 
 // TokenService
 func (s *JWTService) GenerateToken(userID string) (string, error) {
+	u, err := s.users.Get(userID)
+	if err != nil {
+		return "", err
+	}
+
 	claims := jwt.MapClaims{
 		"sub": userID,
+		"sv":  u.SessionVersion,
 		"exp": time.Now().Add(24 * time.Hour).Unix(),
 	}
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
@@ -48,5 +60,12 @@ func (s *JWTService) ParseToken(tokenStr string) (string, error) {
 		return "", errors.New("invalid sub claim")
 	}
 
+	// tokens from before session versions count as version 0
+	version, _ := claims["sv"].(float64)
+	u, err := s.users.Get(sub)
+	if err != nil || int(version) != u.SessionVersion {
+		return "", errors.New("invalid token")
+	}
+
 	return sub, nil
 }
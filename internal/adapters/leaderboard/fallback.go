@@ -0,0 +1,206 @@
+package leaderboard
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// Board is a leaderboard that can report its own health.
+type Board interface {
+	ports.LeaderboardPort
+	ports.LeaderboardHealthPort
+}
+
+// healthTTL limits how often the primary is pinged on the request path.
+const healthTTL = 5 * time.Second
+
+// FallbackLeaderboard serves from the primary (Redis) board and switches to
+// the fallback (Postgres) board while the primary is unreachable, so
+// leaderboards keep working degraded instead of failing. Writes only go to
+// the primary: the fallback reads participant points that are persisted
+// anyway. When the primary comes back, reads stay on the fallback until the
+// OnRecover hook has rebuilt it.
+type FallbackLeaderboard struct {
+	primary  Board
+	fallback Board
+
+	mu         sync.Mutex
+	checkedAt  time.Time
+	up         bool
+	recovering bool // the primary is back and being rebuilt
+	onRecover  func() error
+}
+
+func NewFallbackLeaderboard(primary, fallback Board) *FallbackLeaderboard {
+	return &FallbackLeaderboard{primary: primary, fallback: fallback}
+}
+
+// OnRecover sets what brings the primary up to date after an outage, e.g.
+// rebuilding the open competitions' boards. It runs in the background each
+// time the primary comes back; if it fails the primary counts as down again.
+func (l *FallbackLeaderboard) OnRecover(fn func() error) {
+	l.mu.Lock()
+	l.onRecover = fn
+	l.mu.Unlock()
+}
+
+// primaryUp pings the primary at most once per healthTTL. It stays false
+// while the primary is being rebuilt after an outage.
+func (l *FallbackLeaderboard) primaryUp(ctx context.Context) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if time.Since(l.checkedAt) < healthTTL {
+		return l.up && !l.recovering
+	}
+
+	up := l.primary.Ping(ctx) == nil
+	back := up && !l.up && !l.checkedAt.IsZero()
+	if up != l.up && !l.checkedAt.IsZero() {
+		if up {
+			log.Printf("[Leaderboard] primary board is back")
+		} else {
+			log.Printf("[Leaderboard] primary board unreachable, serving from fallback")
+		}
+	}
+	l.up = up
+	l.checkedAt = time.Now()
+
+	if back && l.onRecover != nil && !l.recovering {
+		l.recovering = true
+		go l.rebuildPrimary(l.onRecover)
+	}
+	return up && !l.recovering
+}
+
+// rebuildPrimary runs the OnRecover hook and switches reads back to the
+// primary once it succeeds.
+func (l *FallbackLeaderboard) rebuildPrimary(fn func() error) {
+	err := fn()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.recovering = false
+	if err != nil {
+		log.Printf("[Leaderboard] failed to rebuild the primary board, staying on fallback: %v", err)
+		l.up = false
+		l.checkedAt = time.Now()
+		return
+	}
+	log.Printf("[Leaderboard] primary board rebuilt, serving from it again")
+}
+
+// markDown forces the next calls onto the fallback after a failed call.
+func (l *FallbackLeaderboard) markDown() {
+	l.mu.Lock()
+	l.up = false
+	l.checkedAt = time.Now()
+	l.mu.Unlock()
+}
+
+// Ping succeeds while either board can serve reads.
+func (l *FallbackLeaderboard) Ping(ctx context.Context) error {
+	if l.primaryUp(ctx) {
+		return nil
+	}
+	return l.fallback.Ping(ctx)
+}
+
+// Degraded reports whether reads are currently served by the fallback.
+func (l *FallbackLeaderboard) Degraded(ctx context.Context) bool {
+	return !l.primaryUp(ctx)
+}
+
+// --------------------------------------------------
+// WRITES
+// --------------------------------------------------
+
+func (l *FallbackLeaderboard) AddScore(ctx context.Context, competitionID string, userID string, delta float64) (float64, error) {
+	if l.primaryUp(ctx) {
+		score, err := l.primary.AddScore(ctx, competitionID, userID, delta)
+		if err == nil {
+			return score, nil
+		}
+		l.markDown()
+	}
+	return l.fallback.AddScore(ctx, competitionID, userID, delta)
+}
+
+func (l *FallbackLeaderboard) SetScore(ctx context.Context, competitionID string, userID string, score float64) error {
+	return l.write(ctx, func(b Board) error { return b.SetScore(ctx, competitionID, userID, score) })
+}
+
+func (l *FallbackLeaderboard) RemoveMember(ctx context.Context, competitionID string, userID string) error {
+	return l.write(ctx, func(b Board) error { return b.RemoveMember(ctx, competitionID, userID) })
+}
+
+func (l *FallbackLeaderboard) AddTeamScore(ctx context.Context, competitionID string, teamID string, delta float64) (float64, error) {
+	if l.primaryUp(ctx) {
+		score, err := l.primary.AddTeamScore(ctx, competitionID, teamID, delta)
+		if err == nil {
+			return score, nil
+		}
+		l.markDown()
+	}
+	return l.fallback.AddTeamScore(ctx, competitionID, teamID, delta)
+}
+
+func (l *FallbackLeaderboard) SetTeamScore(ctx context.Context, competitionID string, teamID string, score float64) error {
+	return l.write(ctx, func(b Board) error { return b.SetTeamScore(ctx, competitionID, teamID, score) })
+}
+
+func (l *FallbackLeaderboard) Clear(ctx context.Context, competitionID string) error {
+	return l.write(ctx, func(b Board) error { return b.Clear(ctx, competitionID) })
+}
+
+func (l *FallbackLeaderboard) Rebuild(ctx context.Context, competitionID string, entries []ports.LeaderboardEntry, teams []ports.TeamLeaderboardEntry) error {
+	return l.write(ctx, func(b Board) error { return b.Rebuild(ctx, competitionID, entries, teams) })
+}
+
+func (l *FallbackLeaderboard) write(ctx context.Context, fn func(Board) error) error {
+	if l.primaryUp(ctx) {
+		if err := fn(l.primary); err == nil {
+			return nil
+		}
+		l.markDown()
+	}
+	return fn(l.fallback)
+}
+
+// --------------------------------------------------
+// READS
+// --------------------------------------------------
+
+func (l *FallbackLeaderboard) GetTop(ctx context.Context, competitionID string, limit int) ([]ports.LeaderboardEntry, error) {
+	if l.primaryUp(ctx) {
+		if out, err := l.primary.GetTop(ctx, competitionID, limit); err == nil {
+			return out, nil
+		}
+		l.markDown()
+	}
+	return l.fallback.GetTop(ctx, competitionID, limit)
+}
+
+func (l *FallbackLeaderboard) GetRank(ctx context.Context, competitionID string, userID string) (int64, float64, error) {
+	if l.primaryUp(ctx) {
+		if rank, score, err := l.primary.GetRank(ctx, competitionID, userID); err == nil {
+			return rank, score, nil
+		}
+		l.markDown()
+	}
+	return l.fallback.GetRank(ctx, competitionID, userID)
+}
+
+func (l *FallbackLeaderboard) GetTopTeams(ctx context.Context, competitionID string, limit int) ([]ports.TeamLeaderboardEntry, error) {
+	if l.primaryUp(ctx) {
+		if out, err := l.primary.GetTopTeams(ctx, competitionID, limit); err == nil {
+			return out, nil
+		}
+		l.markDown()
+	}
+	return l.fallback.GetTopTeams(ctx, competitionID, limit)
+}
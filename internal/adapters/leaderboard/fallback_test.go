@@ -0,0 +1,81 @@
+package leaderboard
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/bakhtybayevn/powerbook/internal/adapters/memory"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// flakyBoard is a memory board whose health can be switched off.
+type flakyBoard struct {
+	*memory.Leaderboard
+	down atomic.Bool
+}
+
+func (b *flakyBoard) Ping(ctx context.Context) error {
+	if b.down.Load() {
+		return errors.New("connection refused")
+	}
+	return nil
+}
+
+// expireHealth makes the next call ping the primary again.
+func (l *FallbackLeaderboard) expireHealth() {
+	l.mu.Lock()
+	l.checkedAt = time.Now().Add(-healthTTL)
+	l.mu.Unlock()
+}
+
+func TestFallbackRebuildsPrimaryWhenItComesBack(t *testing.T) {
+	ctx := context.Background()
+	primary := &flakyBoard{Leaderboard: memory.NewLeaderboard()}
+	fallback := memory.NewLeaderboard()
+	lb := NewFallbackLeaderboard(primary, fallback)
+
+	release := make(chan struct{})
+	var rebuilds atomic.Int32
+	lb.OnRecover(func() error {
+		<-release
+		rebuilds.Add(1)
+		return primary.Rebuild(ctx, "c1", []ports.LeaderboardEntry{{UserID: "ann", Score: 40}}, nil)
+	})
+
+	// a flushed primary comes back while the fallback has the real scores
+	if lb.Degraded(ctx) {
+		t.Fatalf("degraded before any outage")
+	}
+	primary.down.Store(true)
+	lb.expireHealth()
+	if !lb.Degraded(ctx) {
+		t.Fatalf("not degraded while the primary is down")
+	}
+	if err := fallback.SetScore(ctx, "c1", "ann", 40); err != nil {
+		t.Fatalf("set fallback score: %v", err)
+	}
+
+	primary.down.Store(false)
+	lb.expireHealth()
+	if _, score, err := lb.GetRank(ctx, "c1", "ann"); err != nil || score != 40 {
+		t.Errorf("read during the rebuild = %v, %v; want the fallback's 40", score, err)
+	}
+
+	close(release)
+	deadline := time.Now().Add(2 * time.Second)
+	for lb.Degraded(ctx) {
+		if time.Now().After(deadline) {
+			t.Fatalf("still degraded after the rebuild")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if n := rebuilds.Load(); n != 1 {
+		t.Errorf("rebuilt %d times, want 1", n)
+	}
+	if _, score, err := primary.GetRank(ctx, "c1", "ann"); err != nil || score != 40 {
+		t.Errorf("primary score after the rebuild = %v, %v; want 40", score, err)
+	}
+}
@@ -0,0 +1,44 @@
+package memory
+
+import (
+	"sync"
+
+	"github.com/bakhtybayevn/powerbook/internal/domain/achievement"
+)
+
+type AchievementRepo struct {
+	mu      sync.RWMutex
+	unlocks []achievement.Unlock // in insertion order
+}
+
+func NewAchievementRepo() *AchievementRepo {
+	return &AchievementRepo{}
+}
+
+func (r *AchievementRepo) Unlock(u *achievement.Unlock) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, have := range r.unlocks {
+		if have.UserID == u.UserID && have.AchievementID == u.AchievementID {
+			return false, nil
+		}
+	}
+	r.unlocks = append(r.unlocks, *u)
+	return true, nil
+}
+
+// ListByUser returns the user's unlocks, oldest first.
+func (r *AchievementRepo) ListByUser(userID string) ([]*achievement.Unlock, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var list []*achievement.Unlock
+	for _, u := range r.unlocks {
+		if u.UserID == userID {
+			u := u
+			list = append(list, &u)
+		}
+	}
+	return list, nil
+}
@@ -0,0 +1,83 @@
+package memory
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/book"
+)
+
+type BookRepo struct {
+	mu    sync.RWMutex
+	books map[string]book.Book
+}
+
+func NewBookRepo() *BookRepo {
+	return &BookRepo{books: map[string]book.Book{}}
+}
+
+func (r *BookRepo) Save(b *book.Book) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if b.ISBN != "" {
+		for id, other := range r.books {
+			if id != b.ID && other.ISBN == b.ISBN {
+				return core.New(core.ServerError, "failed to save book")
+			}
+		}
+	}
+	r.books[b.ID] = *b
+	return nil
+}
+
+func (r *BookRepo) Get(id string) (*book.Book, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	b, ok := r.books[id]
+	if !ok {
+		return nil, core.New(core.NotFoundError, "book not found")
+	}
+	return &b, nil
+}
+
+func (r *BookRepo) FindByISBN(isbn string) (*book.Book, error) {
+	isbn = book.NormalizeISBN(isbn)
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, b := range r.books {
+		if b.ISBN != "" && b.ISBN == isbn {
+			return &b, nil
+		}
+	}
+	return nil, core.New(core.NotFoundError, "book not found")
+}
+
+// Search matches title or author case-insensitively, or the exact ISBN.
+func (r *BookRepo) Search(query string, limit int) ([]*book.Book, error) {
+	q := strings.ToLower(query)
+
+	r.mu.RLock()
+	var list []*book.Book
+	for _, b := range r.books {
+		if q == "" ||
+			strings.Contains(strings.ToLower(b.Title), q) ||
+			strings.Contains(strings.ToLower(b.Author), q) ||
+			b.ISBN == query {
+			b := b
+			list = append(list, &b)
+		}
+	}
+	r.mu.RUnlock()
+
+	sort.SliceStable(list, func(i, j int) bool { return list[i].Title < list[j].Title })
+	if limit >= 0 && len(list) > limit {
+		list = list[:limit]
+	}
+	return list, nil
+}
@@ -0,0 +1,532 @@
+package memory
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/competition"
+)
+
+type memberKey struct {
+	competitionID string
+	userID        string
+}
+
+// CompetitionRepo mirrors the table layout of the SQL adapter: competitions,
+// participants, teams, organizers, join requests, kicks and gift exchanges
+// are stored separately and assembled on read.
+type CompetitionRepo struct {
+	mu sync.RWMutex
+
+	competitions map[string]competition.Competition // without participants, teams, organizers
+	participants map[string]map[string]competition.Participant
+	teams        map[string]map[string]competition.Team
+	organizers   map[string][]string
+	joinRequests map[memberKey]competition.JoinRequest
+	joinOrder    []memberKey
+	kicks        map[memberKey]bool
+	gifts        map[string]competition.GiftExchange
+	giftOrder    []string
+	results      map[string][]competition.Result
+}
+
+func NewCompetitionRepo() *CompetitionRepo {
+	return &CompetitionRepo{
+		competitions: map[string]competition.Competition{},
+		participants: map[string]map[string]competition.Participant{},
+		teams:        map[string]map[string]competition.Team{},
+		organizers:   map[string][]string{},
+		joinRequests: map[memberKey]competition.JoinRequest{},
+		kicks:        map[memberKey]bool{},
+		gifts:        map[string]competition.GiftExchange{},
+		results:      map[string][]competition.Result{},
+	}
+}
+
+func competitionRow(c *competition.Competition) competition.Competition {
+	row := *c
+	row.Participants = nil
+	row.Teams = nil
+	row.Organizers = nil
+	if row.Format == "" {
+		row.Format = competition.FormatIndividual
+	}
+	if row.Visibility == "" {
+		row.Visibility = competition.VisibilityPublic
+	}
+	return row
+}
+
+// --------------------------------------------------
+// CREATE / SAVE / DELETE
+// --------------------------------------------------
+func (r *CompetitionRepo) Create(c *competition.Competition) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.competitions[c.ID]; exists {
+		return core.New(core.ServerError, "failed to create competition")
+	}
+	if c.InviteCode != "" && r.inviteCodeTaken(c.ID, c.InviteCode) {
+		return core.New(core.ServerError, "failed to create competition")
+	}
+	r.competitions[c.ID] = competitionRow(c)
+	return nil
+}
+
+// Save updates the competition's own fields; members are saved separately
+// and status only moves through TransitionStatus.
+func (r *CompetitionRepo) Save(c *competition.Competition) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	old, ok := r.competitions[c.ID]
+	if !ok {
+		return nil // UPDATE of a missing row
+	}
+	if c.InviteCode != "" && r.inviteCodeTaken(c.ID, c.InviteCode) {
+		return core.New(core.ServerError, "failed to save competition")
+	}
+	row := competitionRow(c)
+	row.SeriesID = old.SeriesID // fixed at creation
+	row.Status = old.Status
+	row.XPPolicyVersion = old.XPPolicyVersion
+	r.competitions[c.ID] = row
+	return nil
+}
+
+func (r *CompetitionRepo) TransitionStatus(id string, from, to competition.Status) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	row, ok := r.competitions[id]
+	if !ok || row.Status != from {
+		return false, nil
+	}
+	row.Status = to
+	r.competitions[id] = row
+	return true, nil
+}
+
+func (r *CompetitionRepo) SetXPPolicyVersion(id string, version int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	row, ok := r.competitions[id]
+	if !ok {
+		return nil // UPDATE of a missing row
+	}
+	row.XPPolicyVersion = version
+	r.competitions[id] = row
+	return nil
+}
+
+func (r *CompetitionRepo) inviteCodeTaken(id, code string) bool {
+	for oid, other := range r.competitions {
+		if oid != id && strings.EqualFold(other.InviteCode, code) {
+			return true
+		}
+	}
+	return false
+}
+
+// Delete drops the competition with everything that references it.
+func (r *CompetitionRepo) Delete(id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.competitions[id]; !ok {
+		return core.New(core.NotFoundError, "competition not found")
+	}
+
+	delete(r.competitions, id)
+	delete(r.participants, id)
+	delete(r.teams, id)
+	delete(r.organizers, id)
+	delete(r.results, id)
+
+	for k := range r.kicks {
+		if k.competitionID == id {
+			delete(r.kicks, k)
+		}
+	}
+
+	keptJoins := r.joinOrder[:0]
+	for _, k := range r.joinOrder {
+		if k.competitionID == id {
+			delete(r.joinRequests, k)
+			continue
+		}
+		keptJoins = append(keptJoins, k)
+	}
+	r.joinOrder = keptJoins
+
+	keptGifts := r.giftOrder[:0]
+	for _, gid := range r.giftOrder {
+		if r.gifts[gid].CompetitionID == id {
+			delete(r.gifts, gid)
+			continue
+		}
+		keptGifts = append(keptGifts, gid)
+	}
+	r.giftOrder = keptGifts
+	return nil
+}
+
+// --------------------------------------------------
+// GET
+// --------------------------------------------------
+func (r *CompetitionRepo) Get(id string) (*competition.Competition, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	c, ok := r.get(id)
+	if !ok {
+		return nil, core.New(core.NotFoundError, "competition not found")
+	}
+	return c, nil
+}
+
+// GetForUpdate is Get: the memory unit of work already runs one at a time.
+func (r *CompetitionRepo) GetForUpdate(id string) (*competition.Competition, error) {
+	return r.Get(id)
+}
+
+// get assembles a detached copy of the competition; callers hold the lock.
+func (r *CompetitionRepo) get(id string) (*competition.Competition, bool) {
+	row, ok := r.competitions[id]
+	if !ok {
+		return nil, false
+	}
+
+	c := row
+	c.Participants = make(map[string]*competition.Participant, len(r.participants[id]))
+	for uid, p := range r.participants[id] {
+		cp := p
+		if p.LastLogDate != nil {
+			d := *p.LastLogDate
+			cp.LastLogDate = &d
+		}
+		c.Participants[uid] = &cp
+	}
+
+	c.Teams = make(map[string]*competition.Team, len(r.teams[id]))
+	for tid, t := range r.teams[id] {
+		cp := t
+		c.Teams[tid] = &cp
+	}
+
+	if orgs := r.organizers[id]; len(orgs) > 0 {
+		c.Organizers = append([]string(nil), orgs...)
+	}
+	return &c, true
+}
+
+func (r *CompetitionRepo) FindByInviteCode(code string) (*competition.Competition, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for id, row := range r.competitions {
+		if row.InviteCode != "" && strings.EqualFold(row.InviteCode, code) {
+			c, _ := r.get(id)
+			return c, nil
+		}
+	}
+	return nil, core.New(core.NotFoundError, "competition not found")
+}
+
+// find returns the competitions accepted by keep, ordered by less.
+func (r *CompetitionRepo) find(keep func(competition.Competition) bool, less func(a, b *competition.Competition) bool) []*competition.Competition {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var list []*competition.Competition
+	for id, row := range r.competitions {
+		if !keep(row) {
+			continue
+		}
+		c, _ := r.get(id)
+		list = append(list, c)
+	}
+	if less != nil {
+		sort.SliceStable(list, func(i, j int) bool { return less(list[i], list[j]) })
+	}
+	return list
+}
+
+func (r *CompetitionRepo) FindActive(ts time.Time) ([]*competition.Competition, error) {
+	return r.find(func(c competition.Competition) bool {
+		return c.Status == competition.StatusOpen && !c.StartDate.After(ts) && !c.EndDate.Before(ts)
+	}, nil), nil
+}
+
+// GetAll returns every competition, latest start first.
+func (r *CompetitionRepo) GetAll() ([]*competition.Competition, error) {
+	return r.find(func(competition.Competition) bool { return true },
+		func(a, b *competition.Competition) bool { return a.StartDate.After(b.StartDate) }), nil
+}
+
+// FindBySeries returns the instances of a series, oldest first.
+func (r *CompetitionRepo) FindBySeries(seriesID string) ([]*competition.Competition, error) {
+	return r.find(func(c competition.Competition) bool { return c.SeriesID == seriesID },
+		func(a, b *competition.Competition) bool { return a.StartDate.Before(b.StartDate) }), nil
+}
+
+func (r *CompetitionRepo) FindByUser(userID string) ([]*competition.Competition, error) {
+	r.mu.RLock()
+	var ids []string
+	for cid, members := range r.participants {
+		if _, ok := members[userID]; ok {
+			ids = append(ids, cid)
+		}
+	}
+	r.mu.RUnlock()
+
+	set := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		set[id] = true
+	}
+	return r.find(func(c competition.Competition) bool { return set[c.ID] }, nil), nil
+}
+
+// --------------------------------------------------
+// PARTICIPANTS & KICKS
+// --------------------------------------------------
+func (r *CompetitionRepo) SaveParticipant(cID string, p *competition.Participant) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.competitions[cID]; !ok {
+		return core.New(core.ServerError, "failed to save participant")
+	}
+	if r.participants[cID] == nil {
+		r.participants[cID] = map[string]competition.Participant{}
+	}
+	cp := *p
+	if p.LastLogDate != nil {
+		d := *p.LastLogDate
+		cp.LastLogDate = &d
+	}
+	r.participants[cID][p.UserID] = cp
+	return nil
+}
+
+func (r *CompetitionRepo) DeleteParticipant(cID, userID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.participants[cID], userID)
+	return nil
+}
+
+func (r *CompetitionRepo) RecordKick(cID, userID, kickedBy, reason string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.kicks[memberKey{cID, userID}] = true
+	return nil
+}
+
+func (r *CompetitionRepo) IsKicked(cID, userID string) (bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.kicks[memberKey{cID, userID}], nil
+}
+
+// --------------------------------------------------
+// TEAMS & ORGANIZERS
+// --------------------------------------------------
+func (r *CompetitionRepo) SaveTeam(t *competition.Team) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.competitions[t.CompetitionID]; !ok {
+		return core.New(core.ServerError, "failed to save team")
+	}
+	if r.teams[t.CompetitionID] == nil {
+		r.teams[t.CompetitionID] = map[string]competition.Team{}
+	}
+	r.teams[t.CompetitionID][t.ID] = *t
+	return nil
+}
+
+func (r *CompetitionRepo) AddOrganizer(competitionID, userID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, id := range r.organizers[competitionID] {
+		if id == userID {
+			return nil
+		}
+	}
+	r.organizers[competitionID] = append(r.organizers[competitionID], userID)
+	return nil
+}
+
+func (r *CompetitionRepo) RemoveOrganizer(competitionID, userID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	orgs := r.organizers[competitionID]
+	for i, id := range orgs {
+		if id == userID {
+			r.organizers[competitionID] = append(orgs[:i:i], orgs[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+// --------------------------------------------------
+// JOIN REQUESTS
+// --------------------------------------------------
+func (r *CompetitionRepo) SaveJoinRequest(jr *competition.JoinRequest) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := memberKey{jr.CompetitionID, jr.UserID}
+	if _, exists := r.joinRequests[key]; !exists {
+		r.joinOrder = append(r.joinOrder, key)
+	}
+	r.joinRequests[key] = cloneJoinRequest(jr)
+	return nil
+}
+
+func (r *CompetitionRepo) GetJoinRequest(competitionID, userID string) (*competition.JoinRequest, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	jr, ok := r.joinRequests[memberKey{competitionID, userID}]
+	if !ok {
+		return nil, core.New(core.NotFoundError, "join request not found")
+	}
+	cp := cloneJoinRequest(&jr)
+	return &cp, nil
+}
+
+func (r *CompetitionRepo) ListJoinRequests(competitionID string, status competition.JoinRequestStatus) ([]*competition.JoinRequest, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var list []*competition.JoinRequest
+	for _, key := range r.joinOrder {
+		jr := r.joinRequests[key]
+		if jr.CompetitionID == competitionID && jr.Status == status {
+			cp := cloneJoinRequest(&jr)
+			list = append(list, &cp)
+		}
+	}
+	sort.SliceStable(list, func(i, j int) bool { return list[i].CreatedAt.Before(list[j].CreatedAt) })
+	return list, nil
+}
+
+func cloneJoinRequest(jr *competition.JoinRequest) competition.JoinRequest {
+	cp := *jr
+	if jr.DecidedAt != nil {
+		d := *jr.DecidedAt
+		cp.DecidedAt = &d
+	}
+	return cp
+}
+
+// --------------------------------------------------
+// FINAL RESULTS
+// --------------------------------------------------
+func (r *CompetitionRepo) SaveResults(competitionID string, rows []competition.Result) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.results[competitionID] = append([]competition.Result(nil), rows...)
+	return nil
+}
+
+func (r *CompetitionRepo) GetResults(competitionID string) ([]competition.Result, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	list := append([]competition.Result(nil), r.results[competitionID]...)
+	sort.SliceStable(list, func(i, j int) bool { return list[i].Rank < list[j].Rank })
+	return list, nil
+}
+
+// --------------------------------------------------
+// GIFT EXCHANGE METHODS
+// --------------------------------------------------
+func (r *CompetitionRepo) SaveGiftExchange(g *competition.GiftExchange) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// one gift per giver and competition; later inserts are ignored
+	for _, other := range r.gifts {
+		if other.CompetitionID == g.CompetitionID && other.GiverID == g.GiverID {
+			return nil
+		}
+	}
+
+	cp := *g
+	now := time.Now().UTC()
+	cp.CreatedAt, cp.UpdatedAt = now, now
+	r.gifts[g.ID] = cp
+	r.giftOrder = append(r.giftOrder, g.ID)
+	return nil
+}
+
+func (r *CompetitionRepo) GetGiftExchanges(competitionID string) ([]*competition.GiftExchange, error) {
+	return r.listGifts(func(g competition.GiftExchange) bool { return g.CompetitionID == competitionID }, false), nil
+}
+
+func (r *CompetitionRepo) GetGiftExchange(id string) (*competition.GiftExchange, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	g, ok := r.gifts[id]
+	if !ok {
+		return nil, core.New(core.NotFoundError, "gift exchange not found")
+	}
+	return &g, nil
+}
+
+func (r *CompetitionRepo) UpdateGiftExchange(g *competition.GiftExchange) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.gifts[g.ID]
+	if !ok {
+		return nil
+	}
+	stored.GiftDescription = g.GiftDescription
+	stored.GiverConfirmed = g.GiverConfirmed
+	stored.ReceiverConfirmed = g.ReceiverConfirmed
+	stored.UpdatedAt = time.Now().UTC()
+	r.gifts[g.ID] = stored
+	return nil
+}
+
+func (r *CompetitionRepo) GetUserGiftHistory(userID string) ([]*competition.GiftExchange, error) {
+	return r.listGifts(func(g competition.GiftExchange) bool {
+		return g.GiverID == userID || g.ReceiverID == userID
+	}, true), nil
+}
+
+// listGifts returns matching gifts in insertion order, or newest first.
+func (r *CompetitionRepo) listGifts(keep func(competition.GiftExchange) bool, newestFirst bool) []*competition.GiftExchange {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var list []*competition.GiftExchange
+	for _, id := range r.giftOrder {
+		if g := r.gifts[id]; keep(g) {
+			list = append(list, &g)
+		}
+	}
+	if newestFirst {
+		for i, j := 0, len(list)-1; i < j; i, j = i+1, j-1 {
+			list[i], list[j] = list[j], list[i]
+		}
+	}
+	return list
+}
@@ -0,0 +1,69 @@
+package memory
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/email"
+)
+
+type EmailOutboxRepo struct {
+	mu       sync.Mutex
+	messages map[string]email.OutboxMessage // key: message id
+}
+
+func NewEmailOutboxRepo() *EmailOutboxRepo {
+	return &EmailOutboxRepo{messages: map[string]email.OutboxMessage{}}
+}
+
+func (r *EmailOutboxRepo) Enqueue(m *email.OutboxMessage) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if m.Key != "" {
+		for _, have := range r.messages {
+			if have.Key == m.Key {
+				return false, nil
+			}
+		}
+	}
+	r.messages[m.ID] = *m
+	return true, nil
+}
+
+func (r *EmailOutboxRepo) ClaimDue(now time.Time, limit int, lease time.Duration) ([]*email.OutboxMessage, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var due []*email.OutboxMessage
+	for _, m := range r.messages {
+		if m.Status == email.StatusPending && !m.NextAttemptAt.After(now) {
+			m := m
+			due = append(due, &m)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool { return due[i].NextAttemptAt.Before(due[j].NextAttemptAt) })
+	if len(due) > limit {
+		due = due[:limit]
+	}
+
+	for _, m := range due {
+		stored := r.messages[m.ID]
+		stored.NextAttemptAt = now.Add(lease)
+		r.messages[m.ID] = stored
+	}
+	return due, nil
+}
+
+func (r *EmailOutboxRepo) Save(m *email.OutboxMessage) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.messages[m.ID]; !ok {
+		return core.New(core.NotFoundError, "email not found")
+	}
+	r.messages[m.ID] = *m
+	return nil
+}
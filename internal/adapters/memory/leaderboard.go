@@ -0,0 +1,166 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// Leaderboard behaves like the Redis sorted sets: highest score first, ties
+// broken by member in reverse lexical order.
+type Leaderboard struct {
+	mu    sync.RWMutex
+	users map[string]map[string]float64 // competitionID -> userID -> score
+	teams map[string]map[string]float64 // competitionID -> teamID -> score
+}
+
+func NewLeaderboard() *Leaderboard {
+	return &Leaderboard{
+		users: map[string]map[string]float64{},
+		teams: map[string]map[string]float64{},
+	}
+}
+
+// Ping always succeeds; there is nothing to lose connection to.
+func (l *Leaderboard) Ping(ctx context.Context) error {
+	return nil
+}
+
+type scored struct {
+	member string
+	score  float64
+}
+
+func ranked(board map[string]float64) []scored {
+	out := make([]scored, 0, len(board))
+	for m, s := range board {
+		out = append(out, scored{m, s})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].score != out[j].score {
+			return out[i].score > out[j].score
+		}
+		return out[i].member > out[j].member
+	})
+	return out
+}
+
+func top(board map[string]float64, limit int) []scored {
+	out := ranked(board)
+	if limit > 0 && len(out) > limit {
+		out = out[:limit]
+	}
+	return out
+}
+
+func (l *Leaderboard) incr(boards map[string]map[string]float64, competitionID, member string, delta float64) float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if boards[competitionID] == nil {
+		boards[competitionID] = map[string]float64{}
+	}
+	boards[competitionID][member] += delta
+	return boards[competitionID][member]
+}
+
+func (l *Leaderboard) set(boards map[string]map[string]float64, competitionID, member string, score float64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if boards[competitionID] == nil {
+		boards[competitionID] = map[string]float64{}
+	}
+	boards[competitionID][member] = score
+}
+
+func (l *Leaderboard) AddScore(ctx context.Context, competitionID string, userID string, delta float64) (float64, error) {
+	return l.incr(l.users, competitionID, userID, delta), nil
+}
+
+func (l *Leaderboard) SetScore(ctx context.Context, competitionID string, userID string, score float64) error {
+	l.set(l.users, competitionID, userID, score)
+	return nil
+}
+
+func (l *Leaderboard) GetTop(ctx context.Context, competitionID string, limit int) ([]ports.LeaderboardEntry, error) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	rows := top(l.users[competitionID], limit)
+	out := make([]ports.LeaderboardEntry, 0, len(rows))
+	for _, r := range rows {
+		out = append(out, ports.LeaderboardEntry{UserID: r.member, Score: r.score})
+	}
+	return out, nil
+}
+
+func (l *Leaderboard) GetRank(ctx context.Context, competitionID string, userID string) (int64, float64, error) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	for i, r := range ranked(l.users[competitionID]) {
+		if r.member == userID {
+			return int64(i), r.score, nil
+		}
+	}
+	return -1, 0, nil
+}
+
+func (l *Leaderboard) RemoveMember(ctx context.Context, competitionID string, userID string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.users[competitionID], userID)
+	return nil
+}
+
+func (l *Leaderboard) AddTeamScore(ctx context.Context, competitionID string, teamID string, delta float64) (float64, error) {
+	return l.incr(l.teams, competitionID, teamID, delta), nil
+}
+
+func (l *Leaderboard) SetTeamScore(ctx context.Context, competitionID string, teamID string, score float64) error {
+	l.set(l.teams, competitionID, teamID, score)
+	return nil
+}
+
+func (l *Leaderboard) GetTopTeams(ctx context.Context, competitionID string, limit int) ([]ports.TeamLeaderboardEntry, error) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	rows := top(l.teams[competitionID], limit)
+	out := make([]ports.TeamLeaderboardEntry, 0, len(rows))
+	for _, r := range rows {
+		out = append(out, ports.TeamLeaderboardEntry{TeamID: r.member, Score: r.score})
+	}
+	return out, nil
+}
+
+func (l *Leaderboard) Clear(ctx context.Context, competitionID string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.users, competitionID)
+	delete(l.teams, competitionID)
+	return nil
+}
+
+func (l *Leaderboard) Rebuild(ctx context.Context, competitionID string, entries []ports.LeaderboardEntry, teams []ports.TeamLeaderboardEntry) error {
+	users := make(map[string]float64, len(entries))
+	for _, e := range entries {
+		users[e.UserID] = e.Score
+	}
+	teamScores := make(map[string]float64, len(teams))
+	for _, t := range teams {
+		teamScores[t.TeamID] = t.Score
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.users[competitionID] = users
+	l.teams[competitionID] = teamScores
+	return nil
+}
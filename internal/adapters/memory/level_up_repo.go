@@ -0,0 +1,43 @@
+package memory
+
+import (
+	"sync"
+
+	"github.com/bakhtybayevn/powerbook/internal/domain/xp"
+)
+
+type LevelUpRepo struct {
+	mu     sync.RWMutex
+	events []xp.LevelUp // in insertion order
+}
+
+func NewLevelUpRepo() *LevelUpRepo {
+	return &LevelUpRepo{}
+}
+
+func (r *LevelUpRepo) Record(e *xp.LevelUp) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, have := range r.events {
+		if have.UserID == e.UserID && have.Level == e.Level {
+			return false, nil
+		}
+	}
+	r.events = append(r.events, *e)
+	return true, nil
+}
+
+// ListByUser returns the user's level-ups, newest first.
+func (r *LevelUpRepo) ListByUser(userID string) ([]*xp.LevelUp, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var list []*xp.LevelUp
+	for i := len(r.events) - 1; i >= 0; i-- {
+		if e := r.events[i]; e.UserID == userID {
+			list = append(list, &e)
+		}
+	}
+	return list, nil
+}
@@ -0,0 +1,125 @@
+package memory
+
+import (
+	"sync"
+	"time"
+
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/notification"
+)
+
+type NotificationRepo struct {
+	mu    sync.RWMutex
+	items []notification.Notification // in insertion order
+}
+
+func NewNotificationRepo() *NotificationRepo {
+	return &NotificationRepo{}
+}
+
+func (r *NotificationRepo) Save(n *notification.Notification) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if n.Key != "" {
+		for _, have := range r.items {
+			if have.UserID == n.UserID && have.Key == n.Key {
+				return false, nil
+			}
+		}
+	}
+	r.items = append(r.items, *n)
+	return true, nil
+}
+
+// ListByUser returns the user's notifications, newest first.
+func (r *NotificationRepo) ListByUser(userID string, unreadOnly bool, limit int) ([]*notification.Notification, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	list := make([]*notification.Notification, 0)
+	for i := len(r.items) - 1; i >= 0; i-- {
+		n := r.items[i]
+		if n.UserID != userID || (unreadOnly && n.IsRead()) {
+			continue
+		}
+		list = append(list, &n)
+		if limit > 0 && len(list) == limit {
+			break
+		}
+	}
+	return list, nil
+}
+
+func (r *NotificationRepo) CountUnread(userID string) (int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	count := 0
+	for _, n := range r.items {
+		if n.UserID == userID && !n.IsRead() {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *NotificationRepo) MarkRead(userID, id string, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i := range r.items {
+		n := &r.items[i]
+		if n.ID != id || n.UserID != userID {
+			continue
+		}
+		if !n.IsRead() {
+			n.ReadAt = &at
+		}
+		return nil
+	}
+	return core.New(core.NotFoundError, "notification not found")
+}
+
+func (r *NotificationRepo) MarkAllRead(userID string, at time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	count := 0
+	for i := range r.items {
+		n := &r.items[i]
+		if n.UserID == userID && !n.IsRead() {
+			n.ReadAt = &at
+			count++
+		}
+	}
+	return count, nil
+}
+
+type NotificationPreferenceRepo struct {
+	mu    sync.RWMutex
+	prefs map[string]notification.Preferences
+}
+
+func NewNotificationPreferenceRepo() *NotificationPreferenceRepo {
+	return &NotificationPreferenceRepo{prefs: map[string]notification.Preferences{}}
+}
+
+func (r *NotificationPreferenceRepo) Get(userID string) (*notification.Preferences, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	p, ok := r.prefs[userID]
+	if !ok {
+		return notification.DefaultPreferences(userID), nil
+	}
+	return &p, nil
+}
+
+func (r *NotificationPreferenceRepo) Save(p *notification.Preferences) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.prefs[p.UserID] = *p
+	return nil
+}
@@ -0,0 +1,66 @@
+package memory
+
+import (
+	"sync"
+
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/user"
+)
+
+type PasswordResetRepo struct {
+	mu     sync.Mutex
+	resets map[string]user.PasswordResetToken // key: token hash
+}
+
+func NewPasswordResetRepo() *PasswordResetRepo {
+	return &PasswordResetRepo{resets: map[string]user.PasswordResetToken{}}
+}
+
+func (r *PasswordResetRepo) Save(t *user.PasswordResetToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.resets[t.TokenHash] = *t
+	return nil
+}
+
+func (r *PasswordResetRepo) Take(tokenHash string) (*user.PasswordResetToken, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	t, ok := r.resets[tokenHash]
+	if !ok {
+		return nil, core.New(core.NotFoundError, "password reset not found")
+	}
+	delete(r.resets, tokenHash)
+	return &t, nil
+}
+
+func (r *PasswordResetRepo) LatestByUser(userID string) (*user.PasswordResetToken, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var latest *user.PasswordResetToken
+	for _, t := range r.resets {
+		if t.UserID == userID && (latest == nil || t.CreatedAt.After(latest.CreatedAt)) {
+			t := t
+			latest = &t
+		}
+	}
+	if latest == nil {
+		return nil, core.New(core.NotFoundError, "password reset not found")
+	}
+	return latest, nil
+}
+
+func (r *PasswordResetRepo) DeleteByUser(userID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for hash, t := range r.resets {
+		if t.UserID == userID {
+			delete(r.resets, hash)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package memory
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/reading"
+)
+
+type ReadingRepo struct {
+	mu       sync.RWMutex
+	readings map[string]reading.Reading
+}
+
+func NewReadingRepo() *ReadingRepo {
+	return &ReadingRepo{readings: map[string]reading.Reading{}}
+}
+
+func (r *ReadingRepo) Save(rd *reading.Reading) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.readings[rd.ID] = *rd
+	return nil
+}
+
+func (r *ReadingRepo) Get(id string) (*reading.Reading, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	rd, ok := r.readings[id]
+	if !ok {
+		return nil, core.New(core.NotFoundError, "reading log not found")
+	}
+	return &rd, nil
+}
+
+func (r *ReadingRepo) Update(rd *reading.Reading) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.readings[rd.ID]; !ok {
+		return core.New(core.NotFoundError, "reading log not found")
+	}
+	r.readings[rd.ID] = *rd
+	return nil
+}
+
+func (r *ReadingRepo) Delete(id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.readings, id)
+	return nil
+}
+
+// ListByUser returns the user's logs, newest first.
+func (r *ReadingRepo) ListByUser(userID string) ([]reading.Reading, error) {
+	list := r.filter(func(rd reading.Reading) bool { return rd.UserID == userID })
+	sort.SliceStable(list, func(i, j int) bool { return list[i].Timestamp.After(list[j].Timestamp) })
+	return list, nil
+}
+
+// ListByDateRange returns logs with from <= timestamp < to, oldest first.
+func (r *ReadingRepo) ListByDateRange(userID string, from, to time.Time) ([]reading.Reading, error) {
+	list := r.filter(func(rd reading.Reading) bool {
+		return rd.UserID == userID && !rd.Timestamp.Before(from) && rd.Timestamp.Before(to)
+	})
+	sort.SliceStable(list, func(i, j int) bool { return list[i].Timestamp.Before(list[j].Timestamp) })
+	return list, nil
+}
+
+func (r *ReadingRepo) filter(keep func(reading.Reading) bool) []reading.Reading {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var list []reading.Reading
+	for _, rd := range r.readings {
+		if keep(rd) {
+			list = append(list, rd)
+		}
+	}
+	return list
+}
@@ -0,0 +1,155 @@
+package memory
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/competition"
+)
+
+type SeriesRepo struct {
+	mu        sync.RWMutex
+	series    map[string]competition.Series
+	seasons   map[string]competition.Season
+	standings map[string][]competition.SeasonStanding // seasonID -> rows
+}
+
+func NewSeriesRepo() *SeriesRepo {
+	return &SeriesRepo{
+		series:    map[string]competition.Series{},
+		seasons:   map[string]competition.Season{},
+		standings: map[string][]competition.SeasonStanding{},
+	}
+}
+
+// --------------------------------------------------
+// SERIES
+// --------------------------------------------------
+func (r *SeriesRepo) SaveSeries(s *competition.Series) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cp := *s
+	if old, ok := r.series[s.ID]; ok {
+		// owner, recurrence, anchor and creation time are fixed on insert
+		cp.OwnerID, cp.Recurrence, cp.Anchor, cp.CreatedAt = old.OwnerID, old.Recurrence, old.Anchor, old.CreatedAt
+	}
+	r.series[s.ID] = cp
+	return nil
+}
+
+func (r *SeriesRepo) GetSeries(id string) (*competition.Series, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	s, ok := r.series[id]
+	if !ok {
+		return nil, core.New(core.NotFoundError, "series not found")
+	}
+	return &s, nil
+}
+
+func (r *SeriesRepo) ListActiveSeries() ([]*competition.Series, error) {
+	r.mu.RLock()
+	var list []*competition.Series
+	for _, s := range r.series {
+		if s.Active {
+			s := s
+			list = append(list, &s)
+		}
+	}
+	r.mu.RUnlock()
+
+	sort.SliceStable(list, func(i, j int) bool { return list[i].NextStart.Before(list[j].NextStart) })
+	return list, nil
+}
+
+// --------------------------------------------------
+// SEASONS
+// --------------------------------------------------
+func (r *SeriesRepo) SaveSeason(s *competition.Season) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cp := *s
+	if old, ok := r.seasons[s.ID]; ok {
+		cp.SeriesID, cp.CreatedAt = old.SeriesID, old.CreatedAt
+	}
+	r.seasons[s.ID] = cp
+	return nil
+}
+
+func (r *SeriesRepo) CloseSeason(id string, xpPolicyVersion int) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	s, ok := r.seasons[id]
+	if !ok || s.Status != competition.SeasonOpen {
+		return false, nil
+	}
+	s.Status = competition.SeasonClosed
+	s.XPPolicyVersion = xpPolicyVersion
+	r.seasons[id] = s
+	return true, nil
+}
+
+func (r *SeriesRepo) GetSeason(id string) (*competition.Season, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	s, ok := r.seasons[id]
+	if !ok {
+		return nil, core.New(core.NotFoundError, "season not found")
+	}
+	return &s, nil
+}
+
+func (r *SeriesRepo) ListSeasons(seriesID string) ([]*competition.Season, error) {
+	list := r.listSeasons(func(s competition.Season) bool { return s.SeriesID == seriesID })
+	sort.SliceStable(list, func(i, j int) bool { return list[i].StartDate.Before(list[j].StartDate) })
+	return list, nil
+}
+
+func (r *SeriesRepo) ListOpenSeasonsEndedBefore(t time.Time) ([]*competition.Season, error) {
+	list := r.listSeasons(func(s competition.Season) bool {
+		return s.Status == competition.SeasonOpen && s.EndDate.Before(t)
+	})
+	sort.SliceStable(list, func(i, j int) bool { return list[i].EndDate.Before(list[j].EndDate) })
+	return list, nil
+}
+
+func (r *SeriesRepo) listSeasons(keep func(competition.Season) bool) []*competition.Season {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var list []*competition.Season
+	for _, s := range r.seasons {
+		if keep(s) {
+			s := s
+			list = append(list, &s)
+		}
+	}
+	return list
+}
+
+// --------------------------------------------------
+// SEASON STANDINGS
+// --------------------------------------------------
+func (r *SeriesRepo) SaveSeasonStandings(seasonID string, rows []competition.SeasonStanding) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.standings[seasonID] = append([]competition.SeasonStanding(nil), rows...)
+	return nil
+}
+
+func (r *SeriesRepo) GetSeasonStandings(seasonID string) ([]competition.SeasonStanding, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	list := append([]competition.SeasonStanding(nil), r.standings[seasonID]...)
+	sort.SliceStable(list, func(i, j int) bool { return list[i].Rank < list[j].Rank })
+	return list, nil
+}
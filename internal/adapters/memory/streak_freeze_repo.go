@@ -0,0 +1,43 @@
+package memory
+
+import (
+	"sync"
+
+	"github.com/bakhtybayevn/powerbook/internal/domain/user"
+)
+
+type StreakFreezeRepo struct {
+	mu     sync.RWMutex
+	events []user.StreakFreezeEvent // in insertion order
+}
+
+func NewStreakFreezeRepo() *StreakFreezeRepo {
+	return &StreakFreezeRepo{}
+}
+
+func (r *StreakFreezeRepo) SaveEvent(e *user.StreakFreezeEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cp := *e
+	if e.Day != nil {
+		d := *e.Day
+		cp.Day = &d
+	}
+	r.events = append(r.events, cp)
+	return nil
+}
+
+// ListByUser returns the user's freeze history, newest first.
+func (r *StreakFreezeRepo) ListByUser(userID string) ([]*user.StreakFreezeEvent, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var list []*user.StreakFreezeEvent
+	for i := len(r.events) - 1; i >= 0; i-- {
+		if e := r.events[i]; e.UserID == userID {
+			list = append(list, &e)
+		}
+	}
+	return list, nil
+}
@@ -0,0 +1,78 @@
+package memory
+
+import (
+	"sync"
+
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/user"
+)
+
+type TelegramLinkRepo struct {
+	mu    sync.Mutex
+	codes map[string]user.TelegramLinkCode
+	links map[string]int64 // user id -> telegram user id
+}
+
+func NewTelegramLinkRepo() *TelegramLinkRepo {
+	return &TelegramLinkRepo{codes: map[string]user.TelegramLinkCode{}, links: map[string]int64{}}
+}
+
+func (r *TelegramLinkRepo) SaveCode(c *user.TelegramLinkCode) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.codes[c.Code] = *c
+	return nil
+}
+
+func (r *TelegramLinkRepo) TakeCode(code string) (*user.TelegramLinkCode, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c, ok := r.codes[code]
+	if !ok {
+		return nil, core.New(core.NotFoundError, "link code not found")
+	}
+	delete(r.codes, code)
+	return &c, nil
+}
+
+func (r *TelegramLinkRepo) Link(userID string, telegramID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id, tg := range r.links {
+		if tg == telegramID {
+			delete(r.links, id)
+		}
+	}
+	r.links[userID] = telegramID
+	return nil
+}
+
+func (r *TelegramLinkRepo) Unlink(userID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.links, userID)
+	return nil
+}
+
+func (r *TelegramLinkRepo) ChatID(userID string) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.links[userID], nil
+}
+
+func (r *TelegramLinkRepo) UserID(telegramID int64) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id, tg := range r.links {
+		if tg == telegramID {
+			return id, nil
+		}
+	}
+	return "", nil
+}
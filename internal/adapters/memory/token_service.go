@@ -0,0 +1,68 @@
+package memory
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// tokenTTL matches the lifetime of the JWTs issued in production.
+const tokenTTL = 24 * time.Hour
+
+type session struct {
+	userID    string
+	version   int // the user's session version when the token was issued
+	expiresAt time.Time
+}
+
+// TokenService issues opaque random tokens and remembers who they belong
+// to. It implements both ports.TokenService and ports.AuthService.
+type TokenService struct {
+	mu       sync.RWMutex
+	sessions map[string]session
+	users    ports.UserRepository
+}
+
+func NewTokenService(users ports.UserRepository) *TokenService {
+	return &TokenService{sessions: map[string]session{}, users: users}
+}
+
+func (s *TokenService) GenerateToken(userID string) (string, error) {
+	u, err := s.users.Get(userID)
+	if err != nil {
+		return "", err
+	}
+
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	token := hex.EncodeToString(buf)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sessions[token] = session{userID: userID, version: u.SessionVersion, expiresAt: time.Now().Add(tokenTTL)}
+	return token, nil
+}
+
+func (s *TokenService) ParseToken(token string) (string, error) {
+	s.mu.RLock()
+	sess, ok := s.sessions[token]
+	s.mu.RUnlock()
+
+	if !ok {
+		return "", errors.New("invalid token")
+	}
+	if u, err := s.users.Get(sess.userID); err != nil || u.SessionVersion != sess.version || time.Now().After(sess.expiresAt) {
+		s.mu.Lock()
+		delete(s.sessions, token)
+		s.mu.Unlock()
+		return "", errors.New("invalid token")
+	}
+	return sess.userID, nil
+}
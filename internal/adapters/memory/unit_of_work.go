@@ -0,0 +1,230 @@
+package memory
+
+import (
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/bakhtybayevn/powerbook/internal/domain/competition"
+	"github.com/bakhtybayevn/powerbook/internal/domain/user"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// UnitOfWork runs units of work one at a time against the shared in-memory
+// repositories. Like a transaction, a unit of work that returns an error
+// leaves the memory repositories as they were before it started.
+type UnitOfWork struct {
+	mu    sync.Mutex
+	repos ports.Repositories
+}
+
+func NewUnitOfWork(repos ports.Repositories) *UnitOfWork {
+	return &UnitOfWork{repos: repos}
+}
+
+func (u *UnitOfWork) Do(fn func(tx ports.Repositories) error) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	restore := u.snapshot()
+	if err := fn(u.repos); err != nil {
+		restore()
+		return err
+	}
+	return nil
+}
+
+// snapshotter is a memory repository that can roll back to an earlier state.
+type snapshotter interface {
+	// snapshot copies the current state and returns a func restoring it.
+	snapshot() func()
+}
+
+// snapshot captures every repository that supports it; wrappers around a
+// memory repository (e.g. in tests) are not rolled back.
+func (u *UnitOfWork) snapshot() func() {
+	var restores []func()
+	for _, repo := range []any{
+		u.repos.Users, u.repos.Readings, u.repos.Competitions, u.repos.Freezes,
+		u.repos.XPLedger, u.repos.Achievements, u.repos.XPPolicies, u.repos.LevelUps,
+		u.repos.Series, u.repos.Resets, u.repos.EmailOutbox,
+	} {
+		if s, ok := repo.(snapshotter); ok {
+			restores = append(restores, s.snapshot())
+		}
+	}
+	return func() {
+		for _, restore := range restores {
+			restore()
+		}
+	}
+}
+
+// --------------------------------------------------
+// SNAPSHOTS
+// --------------------------------------------------
+
+func (r *UserRepo) snapshot() func() {
+	r.mu.RLock()
+	users := make(map[string]*user.User, len(r.users))
+	for id, u := range r.users {
+		users[id] = cloneUser(u)
+	}
+	order := slices.Clone(r.order)
+	r.mu.RUnlock()
+
+	return func() {
+		r.mu.Lock()
+		r.users, r.order = users, order
+		r.mu.Unlock()
+	}
+}
+
+func (r *ReadingRepo) snapshot() func() {
+	r.mu.RLock()
+	readings := maps.Clone(r.readings)
+	r.mu.RUnlock()
+
+	return func() {
+		r.mu.Lock()
+		r.readings = readings
+		r.mu.Unlock()
+	}
+}
+
+func (r *CompetitionRepo) snapshot() func() {
+	r.mu.RLock()
+	cp := CompetitionRepo{
+		competitions: maps.Clone(r.competitions),
+		participants: make(map[string]map[string]competition.Participant, len(r.participants)),
+		teams:        make(map[string]map[string]competition.Team, len(r.teams)),
+		organizers:   make(map[string][]string, len(r.organizers)),
+		joinRequests: maps.Clone(r.joinRequests),
+		joinOrder:    slices.Clone(r.joinOrder),
+		kicks:        maps.Clone(r.kicks),
+		gifts:        maps.Clone(r.gifts),
+		giftOrder:    slices.Clone(r.giftOrder),
+		results:      make(map[string][]competition.Result, len(r.results)),
+	}
+	for id, ps := range r.participants {
+		cp.participants[id] = maps.Clone(ps)
+	}
+	for id, ts := range r.teams {
+		cp.teams[id] = maps.Clone(ts)
+	}
+	for id, ids := range r.organizers {
+		cp.organizers[id] = slices.Clone(ids)
+	}
+	for id, rs := range r.results {
+		cp.results[id] = slices.Clone(rs)
+	}
+	r.mu.RUnlock()
+
+	return func() {
+		r.mu.Lock()
+		r.competitions, r.participants, r.teams, r.organizers = cp.competitions, cp.participants, cp.teams, cp.organizers
+		r.joinRequests, r.joinOrder, r.kicks = cp.joinRequests, cp.joinOrder, cp.kicks
+		r.gifts, r.giftOrder, r.results = cp.gifts, cp.giftOrder, cp.results
+		r.mu.Unlock()
+	}
+}
+
+func (r *StreakFreezeRepo) snapshot() func() {
+	r.mu.RLock()
+	events := slices.Clone(r.events)
+	r.mu.RUnlock()
+
+	return func() {
+		r.mu.Lock()
+		r.events = events
+		r.mu.Unlock()
+	}
+}
+
+func (r *XPLedgerRepo) snapshot() func() {
+	r.mu.RLock()
+	transactions := slices.Clone(r.transactions)
+	r.mu.RUnlock()
+
+	return func() {
+		r.mu.Lock()
+		r.transactions = transactions
+		r.mu.Unlock()
+	}
+}
+
+func (r *AchievementRepo) snapshot() func() {
+	r.mu.RLock()
+	unlocks := slices.Clone(r.unlocks)
+	r.mu.RUnlock()
+
+	return func() {
+		r.mu.Lock()
+		r.unlocks = unlocks
+		r.mu.Unlock()
+	}
+}
+
+func (r *XPPolicyRepo) snapshot() func() {
+	r.mu.RLock()
+	policies := slices.Clone(r.policies)
+	r.mu.RUnlock()
+
+	return func() {
+		r.mu.Lock()
+		r.policies = policies
+		r.mu.Unlock()
+	}
+}
+
+func (r *LevelUpRepo) snapshot() func() {
+	r.mu.RLock()
+	events := slices.Clone(r.events)
+	r.mu.RUnlock()
+
+	return func() {
+		r.mu.Lock()
+		r.events = events
+		r.mu.Unlock()
+	}
+}
+
+func (r *SeriesRepo) snapshot() func() {
+	r.mu.RLock()
+	series, seasons := maps.Clone(r.series), maps.Clone(r.seasons)
+	standings := make(map[string][]competition.SeasonStanding, len(r.standings))
+	for id, rows := range r.standings {
+		standings[id] = slices.Clone(rows)
+	}
+	r.mu.RUnlock()
+
+	return func() {
+		r.mu.Lock()
+		r.series, r.seasons, r.standings = series, seasons, standings
+		r.mu.Unlock()
+	}
+}
+
+func (r *PasswordResetRepo) snapshot() func() {
+	r.mu.Lock()
+	resets := maps.Clone(r.resets)
+	r.mu.Unlock()
+
+	return func() {
+		r.mu.Lock()
+		r.resets = resets
+		r.mu.Unlock()
+	}
+}
+
+func (r *EmailOutboxRepo) snapshot() func() {
+	r.mu.Lock()
+	messages := maps.Clone(r.messages)
+	r.mu.Unlock()
+
+	return func() {
+		r.mu.Lock()
+		r.messages = messages
+		r.mu.Unlock()
+	}
+}
@@ -0,0 +1,79 @@
+package memory
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bakhtybayevn/powerbook/internal/domain/reading"
+	"github.com/bakhtybayevn/powerbook/internal/domain/user"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+func TestUnitOfWorkRollsBackOnError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantXP   int
+		wantKept bool
+	}{
+		{name: "committed", wantXP: 50, wantKept: true},
+		{name: "failed", err: errors.New("boom"), wantXP: 0, wantKept: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repos := ports.Repositories{
+				Users:    NewUserRepo(),
+				Readings: NewReadingRepo(),
+				XPLedger: NewXPLedgerRepo(),
+			}
+			u := user.NewUser("reader@example.com", "Reader", "secret123")
+			if err := repos.Users.Save(u); err != nil {
+				t.Fatalf("save user: %v", err)
+			}
+			r := reading.NewReading(u.ID, 30, "paper", time.Now().UTC())
+
+			err := NewUnitOfWork(repos).Do(func(tx ports.Repositories) error {
+				u.XP = 50
+				if err := tx.Users.Save(u); err != nil {
+					return err
+				}
+				if err := tx.Users.UpdatePassword(u.ID, "changed"); err != nil {
+					return err
+				}
+				if err := tx.Readings.Save(r); err != nil {
+					return err
+				}
+				if err := tx.XPLedger.Append(&user.XPTransaction{ID: "t1", UserID: u.ID, Amount: 50}); err != nil {
+					return err
+				}
+				return tt.err
+			})
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+
+			stored, err := repos.Users.Get(u.ID)
+			if err != nil {
+				t.Fatalf("get user: %v", err)
+			}
+			if stored.XP != tt.wantXP {
+				t.Errorf("xp = %d, want %d", stored.XP, tt.wantXP)
+			}
+			if changed := stored.PasswordHash == "changed"; changed != tt.wantKept {
+				t.Errorf("password changed = %v, want %v", changed, tt.wantKept)
+			}
+			if _, err := repos.Readings.Get(r.ID); (err == nil) != tt.wantKept {
+				t.Errorf("reading stored = %v, want %v", err == nil, tt.wantKept)
+			}
+			ledger, err := repos.XPLedger.ListByUser(u.ID)
+			if err != nil {
+				t.Fatalf("list ledger: %v", err)
+			}
+			if (len(ledger) == 1) != tt.wantKept {
+				t.Errorf("%d ledger entries, want the entry kept = %v", len(ledger), tt.wantKept)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package memory
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/user"
+)
+
+// UserRepo keeps users in memory. Like a database it hands out copies, so
+// changes only stick once they are saved.
+type UserRepo struct {
+	mu    sync.RWMutex
+	users map[string]*user.User
+	order []string // insertion order, stands in for created_at
+}
+
+func NewUserRepo() *UserRepo {
+	return &UserRepo{users: map[string]*user.User{}}
+}
+
+func cloneUser(u *user.User) *user.User {
+	cp := *u
+	if u.StreakLastDate != nil {
+		d := *u.StreakLastDate
+		cp.StreakLastDate = &d
+	}
+	if u.StreakStartDate != nil {
+		d := *u.StreakStartDate
+		cp.StreakStartDate = &d
+	}
+	// pending freeze events and XP ledger entries are never stored, same as the SQL adapter
+	cp.TakeFreezeEvents()
+	cp.TakeXPTransactions()
+	return &cp
+}
+
+func (r *UserRepo) Save(u *user.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id, other := range r.users {
+		if id != u.ID && strings.EqualFold(other.Email, u.Email) {
+			return core.New(core.ServerError, "failed to save user")
+		}
+	}
+
+	cp := cloneUser(u)
+	if old, exists := r.users[u.ID]; exists {
+		// like the SQL upsert, the password only changes through UpdatePassword
+		cp.PasswordHash = old.PasswordHash
+		cp.SessionVersion = old.SessionVersion
+	} else {
+		r.order = append(r.order, u.ID)
+	}
+	if cp.Timezone == "" {
+		cp.Timezone = user.DefaultTimezone
+	}
+	r.users[u.ID] = cp
+	return nil
+}
+
+func (r *UserRepo) UpdatePassword(id, passwordHash string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	u, ok := r.users[id]
+	if !ok {
+		return core.New(core.NotFoundError, "user not found")
+	}
+	u.PasswordHash = passwordHash
+	u.SessionVersion++
+	return nil
+}
+
+func (r *UserRepo) UpdateProfile(u *user.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.users[u.ID]
+	if !ok {
+		return core.New(core.NotFoundError, "user not found")
+	}
+	stored.DisplayName = u.DisplayName
+	stored.TelegramHandle = u.TelegramHandle
+	stored.Timezone = u.Timezone
+	return nil
+}
+
+func (r *UserRepo) Get(id string) (*user.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	u, ok := r.users[id]
+	if !ok {
+		return nil, core.New(core.NotFoundError, "user not found")
+	}
+	return cloneUser(u), nil
+}
+
+func (r *UserRepo) FindByEmail(email string) (*user.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, u := range r.users {
+		if u.Email == email {
+			return cloneUser(u), nil
+		}
+	}
+	return nil, core.New(core.NotFoundError, "user not found")
+}
+
+// ListAll returns users newest first.
+func (r *UserRepo) ListAll() ([]*user.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	list := make([]*user.User, 0, len(r.order))
+	for i := len(r.order) - 1; i >= 0; i-- {
+		if u, ok := r.users[r.order[i]]; ok {
+			list = append(list, cloneUser(u))
+		}
+	}
+	return list, nil
+}
+
+func (r *UserRepo) Delete(id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.users, id)
+	for i, oid := range r.order {
+		if oid == id {
+			r.order = append(r.order[:i], r.order[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
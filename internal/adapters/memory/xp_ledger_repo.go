@@ -0,0 +1,38 @@
+package memory
+
+import (
+	"sync"
+
+	"github.com/bakhtybayevn/powerbook/internal/domain/user"
+)
+
+type XPLedgerRepo struct {
+	mu           sync.RWMutex
+	transactions []user.XPTransaction // in insertion order
+}
+
+func NewXPLedgerRepo() *XPLedgerRepo {
+	return &XPLedgerRepo{}
+}
+
+func (r *XPLedgerRepo) Append(t *user.XPTransaction) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.transactions = append(r.transactions, *t)
+	return nil
+}
+
+// ListByUser returns the user's ledger, newest first.
+func (r *XPLedgerRepo) ListByUser(userID string) ([]*user.XPTransaction, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var list []*user.XPTransaction
+	for i := len(r.transactions) - 1; i >= 0; i-- {
+		if t := r.transactions[i]; t.UserID == userID {
+			list = append(list, &t)
+		}
+	}
+	return list, nil
+}
@@ -0,0 +1,68 @@
+package memory
+
+import (
+	"sync"
+
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/xp"
+)
+
+type XPPolicyRepo struct {
+	mu       sync.RWMutex
+	policies []xp.Policy // ascending by version
+}
+
+// NewXPPolicyRepo starts with the default policy as version 1, like the
+// SQL migration.
+func NewXPPolicyRepo() *XPPolicyRepo {
+	return &XPPolicyRepo{policies: []xp.Policy{xp.DefaultPolicy()}}
+}
+
+func clonePolicy(p xp.Policy) *xp.Policy {
+	p.Levels = append([]xp.Level(nil), p.Levels...)
+	return &p
+}
+
+func (r *XPPolicyRepo) Current() (*xp.Policy, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if len(r.policies) == 0 {
+		return nil, core.New(core.NotFoundError, "xp policy not found")
+	}
+	return clonePolicy(r.policies[len(r.policies)-1]), nil
+}
+
+func (r *XPPolicyRepo) Get(version int) (*xp.Policy, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, p := range r.policies {
+		if p.Version == version {
+			return clonePolicy(p), nil
+		}
+	}
+	return nil, core.New(core.NotFoundError, "xp policy not found")
+}
+
+func (r *XPPolicyRepo) List() ([]*xp.Policy, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	list := make([]*xp.Policy, 0, len(r.policies))
+	for i := len(r.policies) - 1; i >= 0; i-- {
+		list = append(list, clonePolicy(r.policies[i]))
+	}
+	return list, nil
+}
+
+func (r *XPPolicyRepo) Create(p *xp.Policy) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if n := len(r.policies); n > 0 && p.Version <= r.policies[n-1].Version {
+		return core.New(core.ServerError, "failed to save xp policy")
+	}
+	r.policies = append(r.policies, *clonePolicy(*p))
+	return nil
+}
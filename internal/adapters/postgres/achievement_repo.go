@@ -0,0 +1,59 @@
+package postgres
+
+import (
+	"database/sql"
+
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/achievement"
+)
+
+type PostgresAchievementRepo struct {
+	db dbtx
+}
+
+func NewPostgresAchievementRepo(db *sql.DB) *PostgresAchievementRepo {
+	return &PostgresAchievementRepo{db: db}
+}
+
+func (r *PostgresAchievementRepo) Unlock(u *achievement.Unlock) (bool, error) {
+	const q = `
+	INSERT INTO user_achievements (user_id, achievement_id, unlocked_at)
+	VALUES ($1,$2,$3)
+	ON CONFLICT (user_id, achievement_id) DO NOTHING;
+	`
+
+	res, err := r.db.Exec(q, u.UserID, u.AchievementID, u.UnlockedAt)
+	if err != nil {
+		return false, core.New(core.ServerError, "failed to save achievement")
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, core.New(core.ServerError, "failed to save achievement")
+	}
+	return n == 1, nil
+}
+
+func (r *PostgresAchievementRepo) ListByUser(userID string) ([]*achievement.Unlock, error) {
+	const q = `
+	SELECT user_id, achievement_id, unlocked_at
+	FROM user_achievements
+	WHERE user_id = $1
+	ORDER BY unlocked_at, achievement_id;
+	`
+
+	rows, err := r.db.Query(q, userID)
+	if err != nil {
+		return nil, core.New(core.ServerError, "failed to load achievements")
+	}
+	defer rows.Close()
+
+	var list []*achievement.Unlock
+	for rows.Next() {
+		var u achievement.Unlock
+		if err := rows.Scan(&u.UserID, &u.AchievementID, &u.UnlockedAt); err != nil {
+			return nil, core.New(core.ServerError, "failed to scan achievement")
+		}
+		list = append(list, &u)
+	}
+	return list, nil
+}
@@ -0,0 +1,113 @@
+package postgres
+
+import (
+	"database/sql"
+
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/book"
+)
+
+type PostgresBookRepo struct {
+	db *sql.DB
+}
+
+func NewPostgresBookRepo(db *sql.DB) *PostgresBookRepo {
+	return &PostgresBookRepo{db: db}
+}
+
+const bookColumns = `id, title, author, isbn, page_count, cover_url, created_at`
+
+func scanBook(row interface{ Scan(dest ...any) error }) (*book.Book, error) {
+	var b book.Book
+	if err := row.Scan(&b.ID, &b.Title, &b.Author, &b.ISBN, &b.PageCount, &b.CoverURL, &b.CreatedAt); err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// ========================================
+// Save book (insert or update)
+// ========================================
+func (r *PostgresBookRepo) Save(b *book.Book) error {
+	const q = `
+	INSERT INTO books (id, title, author, isbn, page_count, cover_url, created_at, updated_at)
+	VALUES ($1,$2,$3,$4,$5,$6,$7,NOW())
+	ON CONFLICT (id) DO UPDATE SET
+	    title = EXCLUDED.title,
+	    author = EXCLUDED.author,
+	    isbn = EXCLUDED.isbn,
+	    page_count = EXCLUDED.page_count,
+	    cover_url = EXCLUDED.cover_url,
+	    updated_at = NOW();
+	`
+
+	_, err := r.db.Exec(q, b.ID, b.Title, b.Author, b.ISBN, b.PageCount, b.CoverURL, b.CreatedAt)
+	if err != nil {
+		return core.New(core.ServerError, "failed to save book")
+	}
+	return nil
+}
+
+// ========================================
+// Get book by ID
+// ========================================
+func (r *PostgresBookRepo) Get(id string) (*book.Book, error) {
+	q := `SELECT ` + bookColumns + ` FROM books WHERE id = $1;`
+
+	b, err := scanBook(r.db.QueryRow(q, id))
+	if err == sql.ErrNoRows {
+		return nil, core.New(core.NotFoundError, "book not found")
+	}
+	if err != nil {
+		return nil, core.New(core.ServerError, "failed to load book")
+	}
+	return b, nil
+}
+
+// ========================================
+// Find book by ISBN
+// ========================================
+func (r *PostgresBookRepo) FindByISBN(isbn string) (*book.Book, error) {
+	q := `SELECT ` + bookColumns + ` FROM books WHERE isbn = $1 AND isbn <> '';`
+
+	b, err := scanBook(r.db.QueryRow(q, book.NormalizeISBN(isbn)))
+	if err == sql.ErrNoRows {
+		return nil, core.New(core.NotFoundError, "book not found")
+	}
+	if err != nil {
+		return nil, core.New(core.ServerError, "failed to load book")
+	}
+	return b, nil
+}
+
+// ========================================
+// Search books by title / author / ISBN
+// ========================================
+func (r *PostgresBookRepo) Search(query string, limit int) ([]*book.Book, error) {
+	q := `
+	SELECT ` + bookColumns + `
+	FROM books
+	WHERE $1 = ''
+	   OR title ILIKE '%' || $1 || '%'
+	   OR author ILIKE '%' || $1 || '%'
+	   OR isbn = $1
+	ORDER BY title ASC
+	LIMIT $2;
+	`
+
+	rows, err := r.db.Query(q, query, limit)
+	if err != nil {
+		return nil, core.New(core.ServerError, "failed to search books")
+	}
+	defer rows.Close()
+
+	var list []*book.Book
+	for rows.Next() {
+		b, err := scanBook(rows)
+		if err != nil {
+			return nil, core.New(core.ServerError, "failed to scan book")
+		}
+		list = append(list, b)
+	}
+	return list, nil
+}
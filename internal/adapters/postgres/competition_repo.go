@@ -9,7 +9,7 @@ import (
 )
 
 type PostgresCompetitionRepo struct {
-	db *sql.DB
+	db dbtx
 }
 
 func NewPostgresCompetitionRepo(db *sql.DB) *PostgresCompetitionRepo {
@@ -21,8 +21,10 @@ func NewPostgresCompetitionRepo(db *sql.DB) *PostgresCompetitionRepo {
 // --------------------------------------------------
 func (r *PostgresCompetitionRepo) Create(c *competition.Competition) error {
 	const q = `
-	INSERT INTO competitions (id, name, start_date, end_date, status, points_per_minute, created_at, updated_at)
-	VALUES ($1,$2,$3,$4,$5,$6,NOW(),NOW());
+	INSERT INTO competitions (id, name, start_date, end_date, status, points_per_minute,
+	    scoring_type, points_per_unit, daily_minute_cap, format,
+	    owner_id, visibility, invite_code, requires_approval, max_participants, series_id, created_at, updated_at)
+	VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,NOW(),NOW());
 	`
 
 	_, err := r.db.Exec(q,
@@ -32,6 +34,16 @@ func (r *PostgresCompetitionRepo) Create(c *competition.Competition) error {
 		c.EndDate,
 		c.Status,
 		c.Rules.PointsPerMinute,
+		string(c.Rules.ScoringType()),
+		c.Rules.PointsPerUnit,
+		c.Rules.DailyMinuteCap,
+		formatOrDefault(c.Format),
+		nullableString(c.OwnerID),
+		visibilityOrDefault(c.Visibility),
+		nullableString(c.InviteCode),
+		c.RequiresApproval,
+		c.MaxParticipants,
+		nullableString(c.SeriesID),
 	)
 
 	if err != nil {
@@ -45,7 +57,9 @@ func (r *PostgresCompetitionRepo) Create(c *competition.Competition) error {
 // --------------------------------------------------
 func (r *PostgresCompetitionRepo) Get(id string) (*competition.Competition, error) {
 	const compQ = `
-	SELECT id, name, start_date, end_date, status, points_per_minute
+	SELECT id, name, start_date, end_date, status, points_per_minute,
+	       scoring_type, points_per_unit, daily_minute_cap, format,
+	       owner_id, visibility, invite_code, requires_approval, max_participants, series_id, xp_policy_version
 	FROM competitions
 	WHERE id = $1;
 	`
@@ -54,9 +68,14 @@ func (r *PostgresCompetitionRepo) Get(id string) (*competition.Competition, erro
 
 	var c competition.Competition
 	var ppm int
+	var scoringType string
+	var ownerID, inviteCode, seriesID sql.NullString
+	var xpPolicyVersion sql.NullInt64
 
 	err := row.Scan(
 		&c.ID, &c.Name, &c.StartDate, &c.EndDate, &c.Status, &ppm,
+		&scoringType, &c.Rules.PointsPerUnit, &c.Rules.DailyMinuteCap, &c.Format,
+		&ownerID, &c.Visibility, &inviteCode, &c.RequiresApproval, &c.MaxParticipants, &seriesID, &xpPolicyVersion,
 	)
 
 	if err == sql.ErrNoRows {
@@ -67,10 +86,16 @@ func (r *PostgresCompetitionRepo) Get(id string) (*competition.Competition, erro
 	}
 
 	c.Rules.PointsPerMinute = ppm
+	c.Rules.Type = competition.ScoringType(scoringType)
+	c.OwnerID = ownerID.String
+	c.InviteCode = inviteCode.String
+	c.SeriesID = seriesID.String
+	c.XPPolicyVersion = int(xpPolicyVersion.Int64)
 
 	// Load participants
 	const pQ = `
-	SELECT user_id, points, days_read, minutes_total, last_log_date
+	SELECT user_id, team_id, points, days_read, minutes_total, last_log_date,
+	       pages_total, books_finished, streak_current, streak_longest, day_minutes, capped_minutes, joined_at
 	FROM participants
 	WHERE competition_id = $1;
 	`
@@ -86,8 +111,10 @@ func (r *PostgresCompetitionRepo) Get(id string) (*competition.Competition, erro
 	for rows.Next() {
 		var p competition.Participant
 		var lastDate *time.Time
+		var teamID sql.NullString
 
-		err = rows.Scan(&p.UserID, &p.Points, &p.DaysRead, &p.MinutesTotal, &lastDate)
+		err = rows.Scan(&p.UserID, &teamID, &p.Points, &p.DaysRead, &p.MinutesTotal, &lastDate,
+			&p.PagesTotal, &p.BooksFinished, &p.StreakCurrent, &p.StreakLongest, &p.DayMinutes, &p.CappedMinutes, &p.JoinedAt)
 		if err != nil {
 			return nil, core.New(core.ServerError, "failed to scan participant")
 		}
@@ -95,24 +122,284 @@ func (r *PostgresCompetitionRepo) Get(id string) (*competition.Competition, erro
 		if lastDate != nil {
 			p.LastLogDate = lastDate
 		}
+		p.TeamID = teamID.String
 
 		c.Participants[p.UserID] = &p
 	}
 
+	teams, err := r.getTeams(id)
+	if err != nil {
+		return nil, err
+	}
+	c.Teams = teams
+
+	organizers, err := r.getOrganizers(id)
+	if err != nil {
+		return nil, err
+	}
+	c.Organizers = organizers
+
 	return &c, nil
 }
 
+func (r *PostgresCompetitionRepo) GetForUpdate(id string) (*competition.Competition, error) {
+	const q = `SELECT id FROM competitions WHERE id = $1 FOR UPDATE;`
+
+	var locked string
+	err := r.db.QueryRow(q, id).Scan(&locked)
+	if err == sql.ErrNoRows {
+		return nil, core.New(core.NotFoundError, "competition not found")
+	}
+	if err != nil {
+		return nil, core.New(core.ServerError, "failed to lock competition")
+	}
+	return r.Get(id)
+}
+
+func (r *PostgresCompetitionRepo) getOrganizers(competitionID string) ([]string, error) {
+	const q = `
+	SELECT user_id
+	FROM competition_organizers
+	WHERE competition_id = $1
+	ORDER BY created_at;
+	`
+
+	rows, err := r.db.Query(q, competitionID)
+	if err != nil {
+		return nil, core.New(core.ServerError, "failed to load organizers")
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, core.New(core.ServerError, "failed to scan organizer")
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// --------------------------------------------------
+// ORGANIZERS
+// --------------------------------------------------
+func (r *PostgresCompetitionRepo) AddOrganizer(competitionID, userID string) error {
+	const q = `
+	INSERT INTO competition_organizers (competition_id, user_id, created_at)
+	VALUES ($1,$2,NOW())
+	ON CONFLICT (competition_id, user_id) DO NOTHING;
+	`
+
+	if _, err := r.db.Exec(q, competitionID, userID); err != nil {
+		return core.New(core.ServerError, "failed to add organizer")
+	}
+	return nil
+}
+
+func (r *PostgresCompetitionRepo) RemoveOrganizer(competitionID, userID string) error {
+	const q = `DELETE FROM competition_organizers WHERE competition_id = $1 AND user_id = $2;`
+
+	if _, err := r.db.Exec(q, competitionID, userID); err != nil {
+		return core.New(core.ServerError, "failed to remove organizer")
+	}
+	return nil
+}
+
+// --------------------------------------------------
+// DELETE (participants, teams, gifts cascade)
+// --------------------------------------------------
+func (r *PostgresCompetitionRepo) Delete(id string) error {
+	res, err := r.db.Exec(`DELETE FROM competitions WHERE id = $1;`, id)
+	if err != nil {
+		return core.New(core.ServerError, "failed to delete competition")
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return core.New(core.NotFoundError, "competition not found")
+	}
+	return nil
+}
+
+func (r *PostgresCompetitionRepo) getTeams(competitionID string) (map[string]*competition.Team, error) {
+	const q = `
+	SELECT id, competition_id, name, captain_id, created_at
+	FROM teams
+	WHERE competition_id = $1;
+	`
+
+	rows, err := r.db.Query(q, competitionID)
+	if err != nil {
+		return nil, core.New(core.ServerError, "failed to load teams")
+	}
+	defer rows.Close()
+
+	teams := map[string]*competition.Team{}
+	for rows.Next() {
+		var t competition.Team
+		var captainID sql.NullString
+		if err := rows.Scan(&t.ID, &t.CompetitionID, &t.Name, &captainID, &t.CreatedAt); err != nil {
+			return nil, core.New(core.ServerError, "failed to scan team")
+		}
+		t.CaptainID = captainID.String
+		teams[t.ID] = &t
+	}
+	return teams, nil
+}
+
+// --------------------------------------------------
+// SAVE TEAM
+// --------------------------------------------------
+func (r *PostgresCompetitionRepo) SaveTeam(t *competition.Team) error {
+	const q = `
+	INSERT INTO teams (id, competition_id, name, captain_id, created_at)
+	VALUES ($1,$2,$3,$4,$5)
+	ON CONFLICT (id) DO UPDATE SET
+	    name = EXCLUDED.name,
+	    captain_id = EXCLUDED.captain_id;
+	`
+
+	_, err := r.db.Exec(q, t.ID, t.CompetitionID, t.Name, nullableString(t.CaptainID), t.CreatedAt)
+	if err != nil {
+		return core.New(core.ServerError, "failed to save team")
+	}
+	return nil
+}
+
+func formatOrDefault(f competition.Format) string {
+	if f == "" {
+		return string(competition.FormatIndividual)
+	}
+	return string(f)
+}
+
+// nullableInt maps 0 to SQL NULL for optional references.
+func nullableInt(n int) interface{} {
+	if n == 0 {
+		return nil
+	}
+	return n
+}
+
+func visibilityOrDefault(v competition.Visibility) string {
+	if v == "" {
+		return string(competition.VisibilityPublic)
+	}
+	return string(v)
+}
+
+// --------------------------------------------------
+// FIND BY INVITE CODE
+// --------------------------------------------------
+func (r *PostgresCompetitionRepo) FindByInviteCode(code string) (*competition.Competition, error) {
+	const q = `SELECT id FROM competitions WHERE UPPER(invite_code) = UPPER($1);`
+
+	var id string
+	err := r.db.QueryRow(q, code).Scan(&id)
+	if err == sql.ErrNoRows {
+		return nil, core.New(core.NotFoundError, "competition not found")
+	}
+	if err != nil {
+		return nil, core.New(core.ServerError, "failed to load competition")
+	}
+	return r.Get(id)
+}
+
+// --------------------------------------------------
+// JOIN REQUESTS
+// --------------------------------------------------
+func (r *PostgresCompetitionRepo) SaveJoinRequest(jr *competition.JoinRequest) error {
+	const q = `
+	INSERT INTO join_requests (competition_id, user_id, team_id, status, created_at, decided_at)
+	VALUES ($1,$2,$3,$4,$5,$6)
+	ON CONFLICT (competition_id, user_id) DO UPDATE SET
+	    team_id = EXCLUDED.team_id,
+	    status = EXCLUDED.status,
+	    created_at = EXCLUDED.created_at,
+	    decided_at = EXCLUDED.decided_at;
+	`
+
+	_, err := r.db.Exec(q, jr.CompetitionID, jr.UserID, nullableString(jr.TeamID), jr.Status, jr.CreatedAt, jr.DecidedAt)
+	if err != nil {
+		return core.New(core.ServerError, "failed to save join request")
+	}
+	return nil
+}
+
+func (r *PostgresCompetitionRepo) GetJoinRequest(competitionID, userID string) (*competition.JoinRequest, error) {
+	const q = `
+	SELECT competition_id, user_id, team_id, status, created_at, decided_at
+	FROM join_requests
+	WHERE competition_id = $1 AND user_id = $2;
+	`
+
+	jr, err := scanJoinRequest(r.db.QueryRow(q, competitionID, userID))
+	if err == sql.ErrNoRows {
+		return nil, core.New(core.NotFoundError, "join request not found")
+	}
+	if err != nil {
+		return nil, core.New(core.ServerError, "failed to load join request")
+	}
+	return jr, nil
+}
+
+func (r *PostgresCompetitionRepo) ListJoinRequests(competitionID string, status competition.JoinRequestStatus) ([]*competition.JoinRequest, error) {
+	const q = `
+	SELECT competition_id, user_id, team_id, status, created_at, decided_at
+	FROM join_requests
+	WHERE competition_id = $1 AND status = $2
+	ORDER BY created_at;
+	`
+
+	rows, err := r.db.Query(q, competitionID, status)
+	if err != nil {
+		return nil, core.New(core.ServerError, "failed to load join requests")
+	}
+	defer rows.Close()
+
+	var list []*competition.JoinRequest
+	for rows.Next() {
+		jr, err := scanJoinRequest(rows)
+		if err != nil {
+			continue
+		}
+		list = append(list, jr)
+	}
+	return list, nil
+}
+
+func scanJoinRequest(row interface{ Scan(dest ...any) error }) (*competition.JoinRequest, error) {
+	var jr competition.JoinRequest
+	var teamID sql.NullString
+	var decidedAt *time.Time
+	if err := row.Scan(&jr.CompetitionID, &jr.UserID, &teamID, &jr.Status, &jr.CreatedAt, &decidedAt); err != nil {
+		return nil, err
+	}
+	jr.TeamID = teamID.String
+	jr.DecidedAt = decidedAt
+	return &jr, nil
+}
+
 // --------------------------------------------------
 // SAVE COMPETITION (update)
 // --------------------------------------------------
 func (r *PostgresCompetitionRepo) Save(c *competition.Competition) error {
+	// status and xp_policy_version are not written here: a copy loaded
+	// before a close or cancel would otherwise reopen the competition
 	const q = `
 	UPDATE competitions
 	SET name = $2,
 	    start_date = $3,
 	    end_date = $4,
-	    status = $5,
-	    points_per_minute = $6,
+	    points_per_minute = $5,
+	    scoring_type = $6,
+	    points_per_unit = $7,
+	    daily_minute_cap = $8,
+	    format = $9,
+	    owner_id = $10,
+	    visibility = $11,
+	    invite_code = $12,
+	    requires_approval = $13,
+	    max_participants = $14,
 	    updated_at = NOW()
 	WHERE id = $1;
 	`
@@ -122,8 +409,16 @@ func (r *PostgresCompetitionRepo) Save(c *competition.Competition) error {
 		c.Name,
 		c.StartDate,
 		c.EndDate,
-		c.Status,
 		c.Rules.PointsPerMinute,
+		string(c.Rules.ScoringType()),
+		c.Rules.PointsPerUnit,
+		c.Rules.DailyMinuteCap,
+		formatOrDefault(c.Format),
+		nullableString(c.OwnerID),
+		visibilityOrDefault(c.Visibility),
+		nullableString(c.InviteCode),
+		c.RequiresApproval,
+		c.MaxParticipants,
 	)
 
 	if err != nil {
@@ -132,18 +427,60 @@ func (r *PostgresCompetitionRepo) Save(c *competition.Competition) error {
 	return nil
 }
 
+// --------------------------------------------------
+// XP POLICY OF THE CLOSE
+// --------------------------------------------------
+func (r *PostgresCompetitionRepo) SetXPPolicyVersion(id string, version int) error {
+	const q = `UPDATE competitions SET xp_policy_version = $2, updated_at = NOW() WHERE id = $1;`
+
+	if _, err := r.db.Exec(q, id, version); err != nil {
+		return core.New(core.ServerError, "failed to save competition")
+	}
+	return nil
+}
+
+// --------------------------------------------------
+// CONDITIONAL STATUS CHANGE (row lock serializes concurrent callers)
+// --------------------------------------------------
+func (r *PostgresCompetitionRepo) TransitionStatus(id string, from, to competition.Status) (bool, error) {
+	const q = `
+	UPDATE competitions
+	SET status = $3,
+	    updated_at = NOW()
+	WHERE id = $1 AND status = $2;
+	`
+
+	res, err := r.db.Exec(q, id, from, to)
+	if err != nil {
+		return false, core.New(core.ServerError, "failed to update competition status")
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, core.New(core.ServerError, "failed to update competition status")
+	}
+	return n == 1, nil
+}
+
 // --------------------------------------------------
 // ADD / UPDATE PARTICIPANT
 // --------------------------------------------------
 func (r *PostgresCompetitionRepo) SaveParticipant(cID string, p *competition.Participant) error {
 	const q = `
-	INSERT INTO participants (competition_id, user_id, points, days_read, minutes_total, last_log_date)
-	VALUES ($1,$2,$3,$4,$5,$6)
+	INSERT INTO participants (competition_id, user_id, points, days_read, minutes_total, last_log_date,
+	    pages_total, books_finished, streak_current, streak_longest, day_minutes, capped_minutes, team_id, joined_at)
+	VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14)
 	ON CONFLICT (competition_id, user_id) DO UPDATE SET
+	    team_id = EXCLUDED.team_id,
 	    points = EXCLUDED.points,
 	    days_read = EXCLUDED.days_read,
 	    minutes_total = EXCLUDED.minutes_total,
-	    last_log_date = EXCLUDED.last_log_date;
+	    last_log_date = EXCLUDED.last_log_date,
+	    pages_total = EXCLUDED.pages_total,
+	    books_finished = EXCLUDED.books_finished,
+	    streak_current = EXCLUDED.streak_current,
+	    streak_longest = EXCLUDED.streak_longest,
+	    day_minutes = EXCLUDED.day_minutes,
+	    capped_minutes = EXCLUDED.capped_minutes;
 	`
 
 	_, err := r.db.Exec(q,
@@ -153,6 +490,14 @@ func (r *PostgresCompetitionRepo) SaveParticipant(cID string, p *competition.Par
 		p.DaysRead,
 		p.MinutesTotal,
 		p.LastLogDate,
+		p.PagesTotal,
+		p.BooksFinished,
+		p.StreakCurrent,
+		p.StreakLongest,
+		p.DayMinutes,
+		p.CappedMinutes,
+		nullableString(p.TeamID),
+		p.JoinedAt,
 	)
 	if err != nil {
 		return core.New(core.ServerError, "failed to save participant")
@@ -160,6 +505,44 @@ func (r *PostgresCompetitionRepo) SaveParticipant(cID string, p *competition.Par
 	return nil
 }
 
+func (r *PostgresCompetitionRepo) DeleteParticipant(cID, userID string) error {
+	const q = `DELETE FROM participants WHERE competition_id = $1 AND user_id = $2;`
+
+	if _, err := r.db.Exec(q, cID, userID); err != nil {
+		return core.New(core.ServerError, "failed to remove participant")
+	}
+	return nil
+}
+
+// --------------------------------------------------
+// KICKS (block re-joining)
+// --------------------------------------------------
+func (r *PostgresCompetitionRepo) RecordKick(cID, userID, kickedBy, reason string) error {
+	const q = `
+	INSERT INTO competition_kicks (competition_id, user_id, kicked_by, reason, created_at)
+	VALUES ($1,$2,$3,$4,NOW())
+	ON CONFLICT (competition_id, user_id) DO UPDATE SET
+	    kicked_by = EXCLUDED.kicked_by,
+	    reason = EXCLUDED.reason,
+	    created_at = EXCLUDED.created_at;
+	`
+
+	if _, err := r.db.Exec(q, cID, userID, nullableString(kickedBy), nullableString(reason)); err != nil {
+		return core.New(core.ServerError, "failed to record kick")
+	}
+	return nil
+}
+
+func (r *PostgresCompetitionRepo) IsKicked(cID, userID string) (bool, error) {
+	const q = `SELECT EXISTS (SELECT 1 FROM competition_kicks WHERE competition_id = $1 AND user_id = $2);`
+
+	var kicked bool
+	if err := r.db.QueryRow(q, cID, userID).Scan(&kicked); err != nil {
+		return false, core.New(core.ServerError, "failed to check kicks")
+	}
+	return kicked, nil
+}
+
 // --------------------------------------------------
 // FIND ACTIVE COMPETITIONS
 // --------------------------------------------------
@@ -176,22 +559,7 @@ func (r *PostgresCompetitionRepo) FindActive(ts time.Time) ([]*competition.Compe
 	if err != nil {
 		return nil, core.New(core.ServerError, "failed to query active competitions")
 	}
-	defer rows.Close()
-
-	var list []*competition.Competition
-	for rows.Next() {
-		var id string
-		if err := rows.Scan(&id); err != nil {
-			continue
-		}
-
-		c, err := r.Get(id)
-		if err == nil {
-			list = append(list, c)
-		}
-	}
-
-	return list, nil
+	return r.getEach(rows)
 }
 
 // --------------------------------------------------
@@ -208,22 +576,25 @@ func (r *PostgresCompetitionRepo) GetAll() ([]*competition.Competition, error) {
 	if err != nil {
 		return nil, core.New(core.ServerError, "failed to load competitions")
 	}
-	defer rows.Close()
+	return r.getEach(rows)
+}
 
-	var list []*competition.Competition
-	for rows.Next() {
-		var id string
-		if err := rows.Scan(&id); err != nil {
-			continue
-		}
+// --------------------------------------------------
+// GET COMPETITIONS OF A SERIES
+// --------------------------------------------------
+func (r *PostgresCompetitionRepo) FindBySeries(seriesID string) ([]*competition.Competition, error) {
+	const q = `
+        SELECT id
+        FROM competitions
+        WHERE series_id = $1
+        ORDER BY start_date;
+    `
 
-		c, err := r.Get(id)
-		if err == nil {
-			list = append(list, c)
-		}
+	rows, err := r.db.Query(q, seriesID)
+	if err != nil {
+		return nil, core.New(core.ServerError, "failed to load series competitions")
 	}
-
-	return list, nil
+	return r.getEach(rows)
 }
 
 // --------------------------------------------------
@@ -240,15 +611,25 @@ func (r *PostgresCompetitionRepo) FindByUser(userID string) ([]*competition.Comp
 	if err != nil {
 		return nil, core.New(core.ServerError, "failed to load user's competitions")
 	}
-	defer rows.Close()
+	return r.getEach(rows)
+}
 
-	var list []*competition.Competition
+// getEach loads the competitions whose ids the rows return. The ids are read
+// to the end first: inside a transaction the connection can't run another
+// query while rows are still open.
+func (r *PostgresCompetitionRepo) getEach(rows *sql.Rows) ([]*competition.Competition, error) {
+	var ids []string
 	for rows.Next() {
 		var id string
 		if err := rows.Scan(&id); err != nil {
 			continue
 		}
+		ids = append(ids, id)
+	}
+	rows.Close()
 
+	var list []*competition.Competition
+	for _, id := range ids {
 		c, err := r.Get(id)
 		if err == nil {
 			list = append(list, c)
@@ -258,6 +639,57 @@ func (r *PostgresCompetitionRepo) FindByUser(userID string) ([]*competition.Comp
 	return list, nil
 }
 
+// --------------------------------------------------
+// FINAL RESULTS (saved inside the close transaction)
+// --------------------------------------------------
+func (r *PostgresCompetitionRepo) SaveResults(competitionID string, rows []competition.Result) error {
+	if _, err := r.db.Exec(`DELETE FROM competition_results WHERE competition_id = $1;`, competitionID); err != nil {
+		return core.New(core.ServerError, "failed to save results")
+	}
+
+	const q = `
+	INSERT INTO competition_results (competition_id, user_id, rank, points, days_read, minutes_total,
+	    xp_earned, gift_group, team_id, team_name, team_rank, created_at)
+	VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,NOW());
+	`
+	for _, res := range rows {
+		_, err := r.db.Exec(q, competitionID, res.UserID, res.Rank, res.Points, res.DaysRead, res.MinutesTotal,
+			res.XPEarned, res.Group, nullableString(res.TeamID), res.TeamName, res.TeamRank)
+		if err != nil {
+			return core.New(core.ServerError, "failed to save results")
+		}
+	}
+	return nil
+}
+
+func (r *PostgresCompetitionRepo) GetResults(competitionID string) ([]competition.Result, error) {
+	const q = `
+	SELECT user_id, rank, points, days_read, minutes_total, xp_earned, gift_group, team_id, team_name, team_rank
+	FROM competition_results
+	WHERE competition_id = $1
+	ORDER BY rank;
+	`
+
+	rows, err := r.db.Query(q, competitionID)
+	if err != nil {
+		return nil, core.New(core.ServerError, "failed to load results")
+	}
+	defer rows.Close()
+
+	var list []competition.Result
+	for rows.Next() {
+		var res competition.Result
+		var teamID sql.NullString
+		if err := rows.Scan(&res.UserID, &res.Rank, &res.Points, &res.DaysRead, &res.MinutesTotal,
+			&res.XPEarned, &res.Group, &teamID, &res.TeamName, &res.TeamRank); err != nil {
+			return nil, core.New(core.ServerError, "failed to scan result")
+		}
+		res.TeamID = teamID.String
+		list = append(list, res)
+	}
+	return list, nil
+}
+
 // --------------------------------------------------
 // GIFT EXCHANGE METHODS
 // --------------------------------------------------
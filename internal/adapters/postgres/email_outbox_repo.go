@@ -0,0 +1,89 @@
+package postgres
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/email"
+)
+
+type PostgresEmailOutboxRepo struct {
+	db dbtx
+}
+
+func NewPostgresEmailOutboxRepo(db *sql.DB) *PostgresEmailOutboxRepo {
+	return &PostgresEmailOutboxRepo{db: db}
+}
+
+func (r *PostgresEmailOutboxRepo) Enqueue(m *email.OutboxMessage) (bool, error) {
+	const q = `
+	INSERT INTO email_outbox (id, dedupe_key, recipient, subject, text_body, html_body, sensitive, status, attempts, next_attempt_at, created_at)
+	VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11)
+	ON CONFLICT (dedupe_key) DO NOTHING;
+	`
+
+	res, err := r.db.Exec(q, m.ID, nullableString(m.Key), m.To, m.Subject, m.Text, m.HTML, m.Sensitive,
+		string(m.Status), m.Attempts, m.NextAttemptAt, m.CreatedAt)
+	if err != nil {
+		return false, core.New(core.ServerError, "failed to enqueue email")
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, core.New(core.ServerError, "failed to enqueue email")
+	}
+	return n == 1, nil
+}
+
+func (r *PostgresEmailOutboxRepo) ClaimDue(now time.Time, limit int, lease time.Duration) ([]*email.OutboxMessage, error) {
+	// SKIP LOCKED lets several relays claim disjoint batches
+	const q = `
+	UPDATE email_outbox SET next_attempt_at = $3
+	WHERE id IN (
+		SELECT id FROM email_outbox
+		WHERE status = 'pending' AND next_attempt_at <= $1
+		ORDER BY next_attempt_at
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED
+	)
+	RETURNING id, dedupe_key, recipient, subject, text_body, html_body, sensitive, status, attempts, next_attempt_at, last_error, created_at;
+	`
+
+	rows, err := r.db.Query(q, now, limit, now.Add(lease))
+	if err != nil {
+		return nil, core.New(core.ServerError, "failed to claim emails")
+	}
+	defer rows.Close()
+
+	var list []*email.OutboxMessage
+	for rows.Next() {
+		var (
+			m      email.OutboxMessage
+			key    sql.NullString
+			status string
+		)
+		if err := rows.Scan(&m.ID, &key, &m.To, &m.Subject, &m.Text, &m.HTML, &m.Sensitive, &status,
+			&m.Attempts, &m.NextAttemptAt, &m.LastError, &m.CreatedAt); err != nil {
+			return nil, core.New(core.ServerError, "failed to scan email")
+		}
+		m.Key = key.String
+		m.Status = email.Status(status)
+		list = append(list, &m)
+	}
+	return list, nil
+}
+
+// Save records the outcome of an attempt, including the bodies, which are
+// empty once a sensitive message is done.
+func (r *PostgresEmailOutboxRepo) Save(m *email.OutboxMessage) error {
+	const q = `
+	UPDATE email_outbox
+	SET status = $2, attempts = $3, next_attempt_at = $4, last_error = $5, sent_at = $6, text_body = $7, html_body = $8
+	WHERE id = $1;
+	`
+
+	if _, err := r.db.Exec(q, m.ID, string(m.Status), m.Attempts, m.NextAttemptAt, m.LastError, m.SentAt, m.Text, m.HTML); err != nil {
+		return core.New(core.ServerError, "failed to update email")
+	}
+	return nil
+}
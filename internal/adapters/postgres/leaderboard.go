@@ -0,0 +1,167 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// PostgresLeaderboard ranks straight from the participants table. It is the
+// degraded read path when Redis is down: participant points are already
+// persisted by the use cases, so the write methods have nothing to store.
+type PostgresLeaderboard struct {
+	db *sql.DB
+}
+
+func NewPostgresLeaderboard(db *sql.DB) *PostgresLeaderboard {
+	return &PostgresLeaderboard{db: db}
+}
+
+func (r *PostgresLeaderboard) Ping(ctx context.Context) error {
+	return r.db.PingContext(ctx)
+}
+
+// --------------------------------------------------
+// WRITES (participants table is the source of truth)
+// --------------------------------------------------
+
+// AddScore returns the stored total; the delta is already in participants.points.
+func (r *PostgresLeaderboard) AddScore(ctx context.Context, competitionID string, userID string, delta float64) (float64, error) {
+	const q = `SELECT points FROM participants WHERE competition_id = $1 AND user_id = $2;`
+
+	var points int
+	err := r.db.QueryRowContext(ctx, q, competitionID, userID).Scan(&points)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, core.New(core.ServerError, "failed to load points")
+	}
+	return float64(points), nil
+}
+
+func (r *PostgresLeaderboard) SetScore(ctx context.Context, competitionID string, userID string, score float64) error {
+	return nil
+}
+
+func (r *PostgresLeaderboard) RemoveMember(ctx context.Context, competitionID string, userID string) error {
+	return nil
+}
+
+// AddTeamScore returns the team's summed member points.
+func (r *PostgresLeaderboard) AddTeamScore(ctx context.Context, competitionID string, teamID string, delta float64) (float64, error) {
+	const q = `SELECT COALESCE(SUM(points), 0) FROM participants WHERE competition_id = $1 AND team_id = $2;`
+
+	var points int
+	if err := r.db.QueryRowContext(ctx, q, competitionID, teamID).Scan(&points); err != nil {
+		return 0, core.New(core.ServerError, "failed to load team points")
+	}
+	return float64(points), nil
+}
+
+func (r *PostgresLeaderboard) SetTeamScore(ctx context.Context, competitionID string, teamID string, score float64) error {
+	return nil
+}
+
+func (r *PostgresLeaderboard) Clear(ctx context.Context, competitionID string) error {
+	return nil
+}
+
+func (r *PostgresLeaderboard) Rebuild(ctx context.Context, competitionID string, entries []ports.LeaderboardEntry, teams []ports.TeamLeaderboardEntry) error {
+	return nil
+}
+
+// --------------------------------------------------
+// READS
+// --------------------------------------------------
+func (r *PostgresLeaderboard) GetTop(ctx context.Context, competitionID string, limit int) ([]ports.LeaderboardEntry, error) {
+	const q = `
+	SELECT user_id, points
+	FROM (
+	    SELECT user_id, points,
+	           ROW_NUMBER() OVER (ORDER BY points DESC, user_id DESC) AS pos
+	    FROM participants
+	    WHERE competition_id = $1
+	) ranked
+	WHERE $2 <= 0 OR pos <= $2
+	ORDER BY pos;
+	`
+
+	rows, err := r.db.QueryContext(ctx, q, competitionID, limit)
+	if err != nil {
+		return nil, core.New(core.ServerError, "failed to load leaderboard")
+	}
+	defer rows.Close()
+
+	var out []ports.LeaderboardEntry
+	for rows.Next() {
+		var userID string
+		var points int
+		if err := rows.Scan(&userID, &points); err != nil {
+			return nil, core.New(core.ServerError, "failed to scan leaderboard")
+		}
+		out = append(out, ports.LeaderboardEntry{UserID: userID, Score: float64(points)})
+	}
+	return out, nil
+}
+
+// GetRank mirrors ZREVRANK: 0-based position, -1 when the user isn't ranked.
+func (r *PostgresLeaderboard) GetRank(ctx context.Context, competitionID string, userID string) (int64, float64, error) {
+	const q = `
+	SELECT pos - 1, points
+	FROM (
+	    SELECT user_id, points,
+	           ROW_NUMBER() OVER (ORDER BY points DESC, user_id DESC) AS pos
+	    FROM participants
+	    WHERE competition_id = $1
+	) ranked
+	WHERE user_id = $2;
+	`
+
+	var rank int64
+	var points int
+	err := r.db.QueryRowContext(ctx, q, competitionID, userID).Scan(&rank, &points)
+	if err == sql.ErrNoRows {
+		return -1, 0, nil
+	}
+	if err != nil {
+		return -1, 0, core.New(core.ServerError, "failed to load rank")
+	}
+	return rank, float64(points), nil
+}
+
+func (r *PostgresLeaderboard) GetTopTeams(ctx context.Context, competitionID string, limit int) ([]ports.TeamLeaderboardEntry, error) {
+	const q = `
+	SELECT team_id, points
+	FROM (
+	    SELECT t.id AS team_id,
+	           COALESCE(SUM(p.points), 0) AS points,
+	           ROW_NUMBER() OVER (ORDER BY COALESCE(SUM(p.points), 0) DESC, t.id DESC) AS pos
+	    FROM teams t
+	    LEFT JOIN participants p ON p.team_id = t.id AND p.competition_id = t.competition_id
+	    WHERE t.competition_id = $1
+	    GROUP BY t.id
+	) ranked
+	WHERE $2 <= 0 OR pos <= $2
+	ORDER BY pos;
+	`
+
+	rows, err := r.db.QueryContext(ctx, q, competitionID, limit)
+	if err != nil {
+		return nil, core.New(core.ServerError, "failed to load team leaderboard")
+	}
+	defer rows.Close()
+
+	var out []ports.TeamLeaderboardEntry
+	for rows.Next() {
+		var teamID string
+		var points int
+		if err := rows.Scan(&teamID, &points); err != nil {
+			return nil, core.New(core.ServerError, "failed to scan team leaderboard")
+		}
+		out = append(out, ports.TeamLeaderboardEntry{TeamID: teamID, Score: float64(points)})
+	}
+	return out, nil
+}
@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"database/sql"
+
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/xp"
+)
+
+type PostgresLevelUpRepo struct {
+	db dbtx
+}
+
+func NewPostgresLevelUpRepo(db *sql.DB) *PostgresLevelUpRepo {
+	return &PostgresLevelUpRepo{db: db}
+}
+
+func (r *PostgresLevelUpRepo) Record(e *xp.LevelUp) (bool, error) {
+	const q = `
+	INSERT INTO level_ups (id, user_id, level, level_name, xp, policy_version,
+	    reward_streak_freezes, reward_flair, reward_public_competitions, created_at)
+	VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10)
+	ON CONFLICT (user_id, level) DO NOTHING;
+	`
+
+	res, err := r.db.Exec(q, e.ID, e.UserID, e.Level, e.LevelName, e.XP, e.PolicyVersion,
+		e.Reward.StreakFreezes, nullableString(e.Reward.Flair), e.Reward.PublicCompetitions, e.CreatedAt)
+	if err != nil {
+		return false, core.New(core.ServerError, "failed to save level-up")
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, core.New(core.ServerError, "failed to save level-up")
+	}
+	return n == 1, nil
+}
+
+func (r *PostgresLevelUpRepo) ListByUser(userID string) ([]*xp.LevelUp, error) {
+	const q = `
+	SELECT id, user_id, level, level_name, xp, policy_version,
+	    reward_streak_freezes, reward_flair, reward_public_competitions, created_at
+	FROM level_ups
+	WHERE user_id = $1
+	ORDER BY created_at DESC, level DESC;
+	`
+
+	rows, err := r.db.Query(q, userID)
+	if err != nil {
+		return nil, core.New(core.ServerError, "failed to load level-ups")
+	}
+	defer rows.Close()
+
+	var list []*xp.LevelUp
+	for rows.Next() {
+		var (
+			e     xp.LevelUp
+			flair sql.NullString
+		)
+		err := rows.Scan(&e.ID, &e.UserID, &e.Level, &e.LevelName, &e.XP, &e.PolicyVersion,
+			&e.Reward.StreakFreezes, &flair, &e.Reward.PublicCompetitions, &e.CreatedAt)
+		if err != nil {
+			return nil, core.New(core.ServerError, "failed to scan level-up")
+		}
+		e.Reward.Flair = flair.String
+		list = append(list, &e)
+	}
+	return list, nil
+}
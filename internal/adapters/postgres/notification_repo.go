@@ -0,0 +1,149 @@
+package postgres
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/notification"
+)
+
+type PostgresNotificationRepo struct {
+	db *sql.DB
+}
+
+func NewPostgresNotificationRepo(db *sql.DB) *PostgresNotificationRepo {
+	return &PostgresNotificationRepo{db: db}
+}
+
+func (r *PostgresNotificationRepo) Save(n *notification.Notification) (bool, error) {
+	const q = `
+	INSERT INTO notifications (id, user_id, kind, title, body, competition_id, dedupe_key, created_at, read_at)
+	VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)
+	ON CONFLICT (user_id, dedupe_key) WHERE dedupe_key IS NOT NULL DO NOTHING;
+	`
+
+	res, err := r.db.Exec(q, n.ID, n.UserID, string(n.Kind), n.Title, n.Body,
+		nullableString(n.CompetitionID), nullableString(n.Key), n.CreatedAt, n.ReadAt)
+	if err != nil {
+		return false, core.New(core.ServerError, "failed to save notification")
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, core.New(core.ServerError, "failed to save notification")
+	}
+	return affected == 1, nil
+}
+
+func (r *PostgresNotificationRepo) ListByUser(userID string, unreadOnly bool, limit int) ([]*notification.Notification, error) {
+	q := `
+	SELECT id, user_id, kind, title, body, competition_id, dedupe_key, created_at, read_at
+	FROM notifications
+	WHERE user_id = $1 AND ($2 = FALSE OR read_at IS NULL)
+	ORDER BY created_at DESC, id
+	`
+	args := []interface{}{userID, unreadOnly}
+	if limit > 0 {
+		q += " LIMIT $3"
+		args = append(args, limit)
+	}
+
+	rows, err := r.db.Query(q, args...)
+	if err != nil {
+		return nil, core.New(core.ServerError, "failed to load notifications")
+	}
+	defer rows.Close()
+
+	list := make([]*notification.Notification, 0)
+	for rows.Next() {
+		var (
+			n                  notification.Notification
+			kind               string
+			competitionID, key sql.NullString
+			readAt             sql.NullTime
+		)
+		if err := rows.Scan(&n.ID, &n.UserID, &kind, &n.Title, &n.Body, &competitionID, &key, &n.CreatedAt, &readAt); err != nil {
+			return nil, core.New(core.ServerError, "failed to scan notification")
+		}
+		n.Kind = notification.Kind(kind)
+		n.CompetitionID = competitionID.String
+		n.Key = key.String
+		if readAt.Valid {
+			at := readAt.Time
+			n.ReadAt = &at
+		}
+		list = append(list, &n)
+	}
+	return list, nil
+}
+
+func (r *PostgresNotificationRepo) CountUnread(userID string) (int, error) {
+	var n int
+	err := r.db.QueryRow(`SELECT COUNT(*) FROM notifications WHERE user_id = $1 AND read_at IS NULL`, userID).Scan(&n)
+	if err != nil {
+		return 0, core.New(core.ServerError, "failed to count notifications")
+	}
+	return n, nil
+}
+
+func (r *PostgresNotificationRepo) MarkRead(userID, id string, at time.Time) error {
+	// already-read notifications keep their first read time
+	res, err := r.db.Exec(`UPDATE notifications SET read_at = COALESCE(read_at, $3) WHERE id = $1 AND user_id = $2`, id, userID, at)
+	if err != nil {
+		return core.New(core.ServerError, "failed to update notification")
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return core.New(core.ServerError, "failed to update notification")
+	}
+	if n == 0 {
+		return core.New(core.NotFoundError, "notification not found")
+	}
+	return nil
+}
+
+func (r *PostgresNotificationRepo) MarkAllRead(userID string, at time.Time) (int, error) {
+	res, err := r.db.Exec(`UPDATE notifications SET read_at = $2 WHERE user_id = $1 AND read_at IS NULL`, userID, at)
+	if err != nil {
+		return 0, core.New(core.ServerError, "failed to update notifications")
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, core.New(core.ServerError, "failed to update notifications")
+	}
+	return int(n), nil
+}
+
+type PostgresNotificationPreferenceRepo struct {
+	db *sql.DB
+}
+
+func NewPostgresNotificationPreferenceRepo(db *sql.DB) *PostgresNotificationPreferenceRepo {
+	return &PostgresNotificationPreferenceRepo{db: db}
+}
+
+func (r *PostgresNotificationPreferenceRepo) Get(userID string) (*notification.Preferences, error) {
+	p := notification.DefaultPreferences(userID)
+	err := r.db.QueryRow(`SELECT email, telegram FROM notification_preferences WHERE user_id = $1`, userID).
+		Scan(&p.Email, &p.Telegram)
+	if err == sql.ErrNoRows {
+		return p, nil
+	}
+	if err != nil {
+		return nil, core.New(core.ServerError, "failed to load notification preferences")
+	}
+	return p, nil
+}
+
+func (r *PostgresNotificationPreferenceRepo) Save(p *notification.Preferences) error {
+	const q = `
+	INSERT INTO notification_preferences (user_id, email, telegram)
+	VALUES ($1,$2,$3)
+	ON CONFLICT (user_id) DO UPDATE SET email = EXCLUDED.email, telegram = EXCLUDED.telegram;
+	`
+
+	if _, err := r.db.Exec(q, p.UserID, p.Email, p.Telegram); err != nil {
+		return core.New(core.ServerError, "failed to save notification preferences")
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"database/sql"
+
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/user"
+)
+
+type PostgresPasswordResetRepo struct {
+	db dbtx
+}
+
+func NewPostgresPasswordResetRepo(db *sql.DB) *PostgresPasswordResetRepo {
+	return &PostgresPasswordResetRepo{db: db}
+}
+
+func (r *PostgresPasswordResetRepo) Save(t *user.PasswordResetToken) error {
+	const q = `INSERT INTO password_reset_tokens (token_hash, user_id, expires_at, created_at) VALUES ($1,$2,$3,$4);`
+
+	if _, err := r.db.Exec(q, t.TokenHash, t.UserID, t.ExpiresAt, t.CreatedAt); err != nil {
+		return core.New(core.ServerError, "failed to save password reset")
+	}
+	return nil
+}
+
+func (r *PostgresPasswordResetRepo) Take(tokenHash string) (*user.PasswordResetToken, error) {
+	const q = `DELETE FROM password_reset_tokens WHERE token_hash = $1 RETURNING token_hash, user_id, expires_at, created_at;`
+
+	var t user.PasswordResetToken
+	err := r.db.QueryRow(q, tokenHash).Scan(&t.TokenHash, &t.UserID, &t.ExpiresAt, &t.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, core.New(core.NotFoundError, "password reset not found")
+	}
+	if err != nil {
+		return nil, core.New(core.ServerError, "failed to load password reset")
+	}
+	return &t, nil
+}
+
+func (r *PostgresPasswordResetRepo) LatestByUser(userID string) (*user.PasswordResetToken, error) {
+	const q = `
+	SELECT token_hash, user_id, expires_at, created_at
+	FROM password_reset_tokens
+	WHERE user_id = $1
+	ORDER BY created_at DESC
+	LIMIT 1;
+	`
+
+	var t user.PasswordResetToken
+	err := r.db.QueryRow(q, userID).Scan(&t.TokenHash, &t.UserID, &t.ExpiresAt, &t.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, core.New(core.NotFoundError, "password reset not found")
+	}
+	if err != nil {
+		return nil, core.New(core.ServerError, "failed to load password reset")
+	}
+	return &t, nil
+}
+
+func (r *PostgresPasswordResetRepo) DeleteByUser(userID string) error {
+	if _, err := r.db.Exec(`DELETE FROM password_reset_tokens WHERE user_id = $1;`, userID); err != nil {
+		return core.New(core.ServerError, "failed to delete password resets")
+	}
+	return nil
+}
@@ -9,7 +9,7 @@ import (
 )
 
 type PostgresReadingRepo struct {
-	db *sql.DB
+	db dbtx
 }
 
 func NewPostgresReadingRepo(db *sql.DB) *PostgresReadingRepo {
@@ -18,8 +18,8 @@ func NewPostgresReadingRepo(db *sql.DB) *PostgresReadingRepo {
 
 func (r *PostgresReadingRepo) Save(rd *reading.Reading) error {
 	const q = `
-	INSERT INTO reading_logs (id, user_id, minutes, source, timestamp, created_at)
-	VALUES ($1, $2, $3, $4, $5, NOW());
+	INSERT INTO reading_logs (id, user_id, minutes, source, timestamp, book_id, pages_read, finished_book, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW());
 	`
 
 	_, err := r.db.Exec(q,
@@ -28,6 +28,9 @@ func (r *PostgresReadingRepo) Save(rd *reading.Reading) error {
 		rd.Minutes,
 		rd.Source,
 		rd.Timestamp,
+		nullableString(rd.BookID),
+		rd.PagesRead,
+		rd.FinishedBook,
 	)
 
 	if err != nil {
@@ -36,9 +39,61 @@ func (r *PostgresReadingRepo) Save(rd *reading.Reading) error {
 	return nil
 }
 
+func (r *PostgresReadingRepo) Get(id string) (*reading.Reading, error) {
+	const q = `
+	SELECT id, user_id, minutes, source, timestamp, book_id, pages_read, finished_book
+	FROM reading_logs
+	WHERE id = $1;
+	`
+
+	rd, err := scanReading(r.db.QueryRow(q, id))
+	if err == sql.ErrNoRows {
+		return nil, core.New(core.NotFoundError, "reading log not found")
+	}
+	if err != nil {
+		return nil, core.New(core.ServerError, "failed to load reading log")
+	}
+	return &rd, nil
+}
+
+func (r *PostgresReadingRepo) Update(rd *reading.Reading) error {
+	const q = `
+	UPDATE reading_logs
+	SET minutes = $2,
+	    source = $3,
+	    timestamp = $4,
+	    book_id = $5,
+	    pages_read = $6,
+	    finished_book = $7
+	WHERE id = $1;
+	`
+
+	_, err := r.db.Exec(q,
+		rd.ID,
+		rd.Minutes,
+		rd.Source,
+		rd.Timestamp,
+		nullableString(rd.BookID),
+		rd.PagesRead,
+		rd.FinishedBook,
+	)
+	if err != nil {
+		return core.New(core.ServerError, "failed to update reading log")
+	}
+	return nil
+}
+
+func (r *PostgresReadingRepo) Delete(id string) error {
+	_, err := r.db.Exec("DELETE FROM reading_logs WHERE id = $1", id)
+	if err != nil {
+		return core.New(core.ServerError, "failed to delete reading log")
+	}
+	return nil
+}
+
 func (r *PostgresReadingRepo) ListByUser(userID string) ([]reading.Reading, error) {
 	const q = `
-	SELECT id, user_id, minutes, source, timestamp
+	SELECT id, user_id, minutes, source, timestamp, book_id, pages_read, finished_book
 	FROM reading_logs
 	WHERE user_id = $1
 	ORDER BY timestamp DESC;
@@ -53,8 +108,8 @@ func (r *PostgresReadingRepo) ListByUser(userID string) ([]reading.Reading, erro
 	var list []reading.Reading
 
 	for rows.Next() {
-		var rd reading.Reading
-		if err := rows.Scan(&rd.ID, &rd.UserID, &rd.Minutes, &rd.Source, &rd.Timestamp); err != nil {
+		rd, err := scanReading(rows)
+		if err != nil {
 			return nil, core.New(core.ServerError, "failed to scan reading log")
 		}
 		list = append(list, rd)
@@ -65,14 +120,14 @@ func (r *PostgresReadingRepo) ListByUser(userID string) ([]reading.Reading, erro
 
 func (r *PostgresReadingRepo) ListByDateRange(userID string, from, to time.Time) ([]reading.Reading, error) {
 	const q = `
-	SELECT id, user_id, minutes, source, timestamp
+	SELECT id, user_id, minutes, source, timestamp, book_id, pages_read, finished_book
 	FROM reading_logs
 	WHERE user_id = $1
-	  AND timestamp BETWEEN $2 AND $3
+	  AND timestamp >= $2 AND timestamp < $3
 	ORDER BY timestamp ASC;
 	`
 
-	rows, err := r.db.Query(q, userID, from, to)
+	rows, err := r.db.Query(q, userID, from.UTC(), to.UTC())
 	if err != nil {
 		return nil, core.New(core.ServerError, "failed to query logs by date range")
 	}
@@ -81,8 +136,8 @@ func (r *PostgresReadingRepo) ListByDateRange(userID string, from, to time.Time)
 	var list []reading.Reading
 
 	for rows.Next() {
-		var rd reading.Reading
-		if err := rows.Scan(&rd.ID, &rd.UserID, &rd.Minutes, &rd.Source, &rd.Timestamp); err != nil {
+		rd, err := scanReading(rows)
+		if err != nil {
 			return nil, core.New(core.ServerError, "failed to scan log")
 		}
 		list = append(list, rd)
@@ -90,3 +145,23 @@ func (r *PostgresReadingRepo) ListByDateRange(userID string, from, to time.Time)
 
 	return list, nil
 }
+
+func scanReading(row interface{ Scan(dest ...any) error }) (reading.Reading, error) {
+	var (
+		rd     reading.Reading
+		bookID sql.NullString
+	)
+	if err := row.Scan(&rd.ID, &rd.UserID, &rd.Minutes, &rd.Source, &rd.Timestamp, &bookID, &rd.PagesRead, &rd.FinishedBook); err != nil {
+		return rd, err
+	}
+	rd.BookID = bookID.String
+	return rd, nil
+}
+
+// nullableString maps "" to SQL NULL for optional foreign keys.
+func nullableString(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
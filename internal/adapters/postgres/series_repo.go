@@ -0,0 +1,232 @@
+package postgres
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/competition"
+)
+
+type PostgresSeriesRepo struct {
+	db dbtx
+}
+
+func NewPostgresSeriesRepo(db *sql.DB) *PostgresSeriesRepo {
+	return &PostgresSeriesRepo{db: db}
+}
+
+const seriesColumns = `id, name, owner_id, recurrence, points_per_minute, scoring_type, points_per_unit,
+	daily_minute_cap, format, visibility, requires_approval, max_participants, anchor, next_start, active, created_at`
+
+func scanSeries(row interface{ Scan(dest ...any) error }) (*competition.Series, error) {
+	var s competition.Series
+	var ownerID sql.NullString
+	var scoringType string
+	err := row.Scan(&s.ID, &s.Name, &ownerID, &s.Recurrence, &s.Rules.PointsPerMinute, &scoringType,
+		&s.Rules.PointsPerUnit, &s.Rules.DailyMinuteCap, &s.Format, &s.Visibility, &s.RequiresApproval,
+		&s.MaxParticipants, &s.Anchor, &s.NextStart, &s.Active, &s.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	s.OwnerID = ownerID.String
+	s.Rules.Type = competition.ScoringType(scoringType)
+	return &s, nil
+}
+
+// --------------------------------------------------
+// SERIES
+// --------------------------------------------------
+func (r *PostgresSeriesRepo) SaveSeries(s *competition.Series) error {
+	const q = `
+	INSERT INTO competition_series (` + seriesColumns + `)
+	VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16)
+	ON CONFLICT (id) DO UPDATE SET
+	    name = EXCLUDED.name,
+	    points_per_minute = EXCLUDED.points_per_minute,
+	    scoring_type = EXCLUDED.scoring_type,
+	    points_per_unit = EXCLUDED.points_per_unit,
+	    daily_minute_cap = EXCLUDED.daily_minute_cap,
+	    format = EXCLUDED.format,
+	    visibility = EXCLUDED.visibility,
+	    requires_approval = EXCLUDED.requires_approval,
+	    max_participants = EXCLUDED.max_participants,
+	    next_start = EXCLUDED.next_start,
+	    active = EXCLUDED.active;
+	`
+
+	_, err := r.db.Exec(q, s.ID, s.Name, nullableString(s.OwnerID), string(s.Recurrence),
+		s.Rules.PointsPerMinute, string(s.Rules.ScoringType()), s.Rules.PointsPerUnit, s.Rules.DailyMinuteCap,
+		formatOrDefault(s.Format), visibilityOrDefault(s.Visibility), s.RequiresApproval, s.MaxParticipants,
+		s.Anchor, s.NextStart, s.Active, s.CreatedAt)
+	if err != nil {
+		return core.New(core.ServerError, "failed to save series")
+	}
+	return nil
+}
+
+func (r *PostgresSeriesRepo) GetSeries(id string) (*competition.Series, error) {
+	q := `SELECT ` + seriesColumns + ` FROM competition_series WHERE id = $1;`
+
+	s, err := scanSeries(r.db.QueryRow(q, id))
+	if err == sql.ErrNoRows {
+		return nil, core.New(core.NotFoundError, "series not found")
+	}
+	if err != nil {
+		return nil, core.New(core.ServerError, "failed to load series")
+	}
+	return s, nil
+}
+
+func (r *PostgresSeriesRepo) ListActiveSeries() ([]*competition.Series, error) {
+	q := `SELECT ` + seriesColumns + ` FROM competition_series WHERE active = TRUE ORDER BY next_start;`
+
+	rows, err := r.db.Query(q)
+	if err != nil {
+		return nil, core.New(core.ServerError, "failed to load series")
+	}
+	defer rows.Close()
+
+	var list []*competition.Series
+	for rows.Next() {
+		s, err := scanSeries(rows)
+		if err != nil {
+			continue
+		}
+		list = append(list, s)
+	}
+	return list, nil
+}
+
+// --------------------------------------------------
+// SEASONS
+// --------------------------------------------------
+const seasonColumns = `id, series_id, name, start_date, end_date, status, created_at, xp_policy_version`
+
+func scanSeason(row interface{ Scan(dest ...any) error }) (*competition.Season, error) {
+	var s competition.Season
+	var xpPolicyVersion sql.NullInt64
+	if err := row.Scan(&s.ID, &s.SeriesID, &s.Name, &s.StartDate, &s.EndDate, &s.Status, &s.CreatedAt, &xpPolicyVersion); err != nil {
+		return nil, err
+	}
+	s.XPPolicyVersion = int(xpPolicyVersion.Int64)
+	return &s, nil
+}
+
+func (r *PostgresSeriesRepo) SaveSeason(s *competition.Season) error {
+	const q = `
+	INSERT INTO seasons (id, series_id, name, start_date, end_date, status, created_at)
+	VALUES ($1,$2,$3,$4,$5,$6,$7)
+	ON CONFLICT (id) DO UPDATE SET
+	    name = EXCLUDED.name,
+	    start_date = EXCLUDED.start_date,
+	    end_date = EXCLUDED.end_date,
+	    status = EXCLUDED.status;
+	`
+
+	_, err := r.db.Exec(q, s.ID, s.SeriesID, s.Name, s.StartDate, s.EndDate, string(s.Status), s.CreatedAt)
+	if err != nil {
+		return core.New(core.ServerError, "failed to save season")
+	}
+	return nil
+}
+
+func (r *PostgresSeriesRepo) CloseSeason(id string, xpPolicyVersion int) (bool, error) {
+	const q = `UPDATE seasons SET status = 'closed', xp_policy_version = $2 WHERE id = $1 AND status = 'open';`
+
+	res, err := r.db.Exec(q, id, xpPolicyVersion)
+	if err != nil {
+		return false, core.New(core.ServerError, "failed to close season")
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, core.New(core.ServerError, "failed to close season")
+	}
+	return n == 1, nil
+}
+
+func (r *PostgresSeriesRepo) GetSeason(id string) (*competition.Season, error) {
+	q := `SELECT ` + seasonColumns + ` FROM seasons WHERE id = $1;`
+
+	s, err := scanSeason(r.db.QueryRow(q, id))
+	if err == sql.ErrNoRows {
+		return nil, core.New(core.NotFoundError, "season not found")
+	}
+	if err != nil {
+		return nil, core.New(core.ServerError, "failed to load season")
+	}
+	return s, nil
+}
+
+func (r *PostgresSeriesRepo) ListSeasons(seriesID string) ([]*competition.Season, error) {
+	q := `SELECT ` + seasonColumns + ` FROM seasons WHERE series_id = $1 ORDER BY start_date;`
+	return r.listSeasons(q, seriesID)
+}
+
+func (r *PostgresSeriesRepo) ListOpenSeasonsEndedBefore(t time.Time) ([]*competition.Season, error) {
+	q := `SELECT ` + seasonColumns + ` FROM seasons WHERE status = 'open' AND end_date < $1 ORDER BY end_date;`
+	return r.listSeasons(q, t)
+}
+
+func (r *PostgresSeriesRepo) listSeasons(q string, arg any) ([]*competition.Season, error) {
+	rows, err := r.db.Query(q, arg)
+	if err != nil {
+		return nil, core.New(core.ServerError, "failed to load seasons")
+	}
+	defer rows.Close()
+
+	var list []*competition.Season
+	for rows.Next() {
+		s, err := scanSeason(rows)
+		if err != nil {
+			continue
+		}
+		list = append(list, s)
+	}
+	return list, nil
+}
+
+// --------------------------------------------------
+// SEASON STANDINGS
+// --------------------------------------------------
+func (r *PostgresSeriesRepo) SaveSeasonStandings(seasonID string, rows []competition.SeasonStanding) error {
+	if _, err := r.db.Exec(`DELETE FROM season_standings WHERE season_id = $1;`, seasonID); err != nil {
+		return core.New(core.ServerError, "failed to save season standings")
+	}
+
+	const q = `
+	INSERT INTO season_standings (season_id, user_id, rank, points, competitions, wins, xp_earned)
+	VALUES ($1,$2,$3,$4,$5,$6,$7);
+	`
+	for _, s := range rows {
+		if _, err := r.db.Exec(q, seasonID, s.UserID, s.Rank, s.Points, s.Competitions, s.Wins, s.XPEarned); err != nil {
+			return core.New(core.ServerError, "failed to save season standings")
+		}
+	}
+	return nil
+}
+
+func (r *PostgresSeriesRepo) GetSeasonStandings(seasonID string) ([]competition.SeasonStanding, error) {
+	const q = `
+	SELECT user_id, rank, points, competitions, wins, xp_earned
+	FROM season_standings
+	WHERE season_id = $1
+	ORDER BY rank;
+	`
+
+	rows, err := r.db.Query(q, seasonID)
+	if err != nil {
+		return nil, core.New(core.ServerError, "failed to load season standings")
+	}
+	defer rows.Close()
+
+	var list []competition.SeasonStanding
+	for rows.Next() {
+		var s competition.SeasonStanding
+		if err := rows.Scan(&s.UserID, &s.Rank, &s.Points, &s.Competitions, &s.Wins, &s.XPEarned); err != nil {
+			continue
+		}
+		list = append(list, s)
+	}
+	return list, nil
+}
@@ -0,0 +1,61 @@
+package postgres
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/user"
+)
+
+type PostgresStreakFreezeRepo struct {
+	db dbtx
+}
+
+func NewPostgresStreakFreezeRepo(db *sql.DB) *PostgresStreakFreezeRepo {
+	return &PostgresStreakFreezeRepo{db: db}
+}
+
+func (r *PostgresStreakFreezeRepo) SaveEvent(e *user.StreakFreezeEvent) error {
+	const q = `
+	INSERT INTO streak_freeze_events (id, user_id, kind, day, xp_cost, created_at)
+	VALUES ($1,$2,$3,$4,$5,$6);
+	`
+
+	_, err := r.db.Exec(q, e.ID, e.UserID, string(e.Kind), e.Day, e.XPCost, e.CreatedAt)
+	if err != nil {
+		return core.New(core.ServerError, "failed to save streak freeze event")
+	}
+	return nil
+}
+
+func (r *PostgresStreakFreezeRepo) ListByUser(userID string) ([]*user.StreakFreezeEvent, error) {
+	const q = `
+	SELECT id, user_id, kind, day, xp_cost, created_at
+	FROM streak_freeze_events
+	WHERE user_id = $1
+	ORDER BY created_at DESC;
+	`
+
+	rows, err := r.db.Query(q, userID)
+	if err != nil {
+		return nil, core.New(core.ServerError, "failed to load streak freeze history")
+	}
+	defer rows.Close()
+
+	var list []*user.StreakFreezeEvent
+	for rows.Next() {
+		var (
+			e    user.StreakFreezeEvent
+			kind string
+			day  *time.Time
+		)
+		if err := rows.Scan(&e.ID, &e.UserID, &kind, &day, &e.XPCost, &e.CreatedAt); err != nil {
+			return nil, core.New(core.ServerError, "failed to scan streak freeze event")
+		}
+		e.Kind = user.StreakFreezeEventKind(kind)
+		e.Day = day
+		list = append(list, &e)
+	}
+	return list, nil
+}
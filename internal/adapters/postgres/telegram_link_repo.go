@@ -0,0 +1,89 @@
+package postgres
+
+import (
+	"database/sql"
+
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/user"
+)
+
+type PostgresTelegramLinkRepo struct {
+	db dbtx
+}
+
+func NewPostgresTelegramLinkRepo(db *sql.DB) *PostgresTelegramLinkRepo {
+	return &PostgresTelegramLinkRepo{db: db}
+}
+
+func (r *PostgresTelegramLinkRepo) SaveCode(c *user.TelegramLinkCode) error {
+	const q = `INSERT INTO telegram_link_codes (code, user_id, expires_at) VALUES ($1,$2,$3);`
+
+	if _, err := r.db.Exec(q, c.Code, c.UserID, c.ExpiresAt); err != nil {
+		return core.New(core.ServerError, "failed to save link code")
+	}
+	return nil
+}
+
+func (r *PostgresTelegramLinkRepo) TakeCode(code string) (*user.TelegramLinkCode, error) {
+	const q = `DELETE FROM telegram_link_codes WHERE code = $1 RETURNING code, user_id, expires_at;`
+
+	var c user.TelegramLinkCode
+	err := r.db.QueryRow(q, code).Scan(&c.Code, &c.UserID, &c.ExpiresAt)
+	if err == sql.ErrNoRows {
+		return nil, core.New(core.NotFoundError, "link code not found")
+	}
+	if err != nil {
+		return nil, core.New(core.ServerError, "failed to load link code")
+	}
+	return &c, nil
+}
+
+func (r *PostgresTelegramLinkRepo) Link(userID string, telegramID int64) error {
+	// a Telegram account belongs to one PowerBook account at a time
+	if _, err := r.db.Exec(`DELETE FROM telegram_links WHERE telegram_id = $1 AND user_id <> $2;`, telegramID, userID); err != nil {
+		return core.New(core.ServerError, "failed to link telegram")
+	}
+
+	const q = `
+	INSERT INTO telegram_links (user_id, telegram_id, linked_at)
+	VALUES ($1,$2,NOW())
+	ON CONFLICT (user_id) DO UPDATE SET telegram_id = EXCLUDED.telegram_id, linked_at = EXCLUDED.linked_at;
+	`
+	if _, err := r.db.Exec(q, userID, telegramID); err != nil {
+		return core.New(core.ServerError, "failed to link telegram")
+	}
+	return nil
+}
+
+func (r *PostgresTelegramLinkRepo) Unlink(userID string) error {
+	if _, err := r.db.Exec(`DELETE FROM telegram_links WHERE user_id = $1;`, userID); err != nil {
+		return core.New(core.ServerError, "failed to unlink telegram")
+	}
+	return nil
+}
+
+// ChatID returns the linked Telegram user id, which doubles as the id of their
+// private chat with the bot.
+func (r *PostgresTelegramLinkRepo) ChatID(userID string) (int64, error) {
+	var chatID int64
+	err := r.db.QueryRow(`SELECT telegram_id FROM telegram_links WHERE user_id = $1;`, userID).Scan(&chatID)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, core.New(core.ServerError, "failed to load telegram link")
+	}
+	return chatID, nil
+}
+
+func (r *PostgresTelegramLinkRepo) UserID(telegramID int64) (string, error) {
+	var userID string
+	err := r.db.QueryRow(`SELECT user_id FROM telegram_links WHERE telegram_id = $1;`, telegramID).Scan(&userID)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", core.New(core.ServerError, "failed to load telegram link")
+	}
+	return userID, nil
+}
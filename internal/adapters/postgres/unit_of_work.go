@@ -0,0 +1,55 @@
+package postgres
+
+import (
+	"database/sql"
+	"log"
+
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// dbtx is what the repositories need from *sql.DB and *sql.Tx, so the same
+// repository code runs standalone or inside a unit of work.
+type dbtx interface {
+	Exec(query string, args ...any) (sql.Result, error)
+	Query(query string, args ...any) (*sql.Rows, error)
+	QueryRow(query string, args ...any) *sql.Row
+}
+
+type PostgresUnitOfWork struct {
+	db *sql.DB
+}
+
+func NewPostgresUnitOfWork(db *sql.DB) *PostgresUnitOfWork {
+	return &PostgresUnitOfWork{db: db}
+}
+
+func (u *PostgresUnitOfWork) Do(fn func(tx ports.Repositories) error) error {
+	tx, err := u.db.Begin()
+	if err != nil {
+		return core.New(core.ServerError, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	if err := fn(ports.Repositories{
+		Users:        &PostgresUserRepo{db: tx},
+		Readings:     &PostgresReadingRepo{db: tx},
+		Competitions: &PostgresCompetitionRepo{db: tx},
+		Freezes:      &PostgresStreakFreezeRepo{db: tx},
+		XPLedger:     &PostgresXPLedgerRepo{db: tx},
+		Achievements: &PostgresAchievementRepo{db: tx},
+		XPPolicies:   &PostgresXPPolicyRepo{db: tx},
+		LevelUps:     &PostgresLevelUpRepo{db: tx},
+		Series:       &PostgresSeriesRepo{db: tx},
+		Resets:       &PostgresPasswordResetRepo{db: tx},
+		EmailOutbox:  &PostgresEmailOutboxRepo{db: tx},
+	}); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Printf("[PostgresUnitOfWork] commit failed: %v", err)
+		return core.New(core.ServerError, "failed to commit transaction")
+	}
+	return nil
+}
@@ -10,7 +10,7 @@ import (
 )
 
 type PostgresUserRepo struct {
-	db *sql.DB
+	db dbtx
 }
 
 func NewPostgresUserRepo(db *sql.DB) *PostgresUserRepo {
@@ -23,18 +23,22 @@ func NewPostgresUserRepo(db *sql.DB) *PostgresUserRepo {
 func (r *PostgresUserRepo) Save(u *user.User) error {
 	const q = `
 	INSERT INTO users (id, email, display_name, password_hash,
-	    streak_current_days, streak_last_date, total_minutes, xp, telegram_handle, is_admin, created_at, updated_at)
-	VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,NOW(),NOW())
+	    streak_current_days, streak_last_date, total_minutes, xp, telegram_handle, is_admin, timezone, streak_freezes, session_version,
+	    streak_start_date, streak_freeze_milestone, created_at, updated_at)
+	VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,NOW(),NOW())
 	ON CONFLICT (id) DO UPDATE SET
 	    email = EXCLUDED.email,
 	    display_name = EXCLUDED.display_name,
-	    password_hash = EXCLUDED.password_hash,
 	    streak_current_days = EXCLUDED.streak_current_days,
 	    streak_last_date = EXCLUDED.streak_last_date,
 	    total_minutes = EXCLUDED.total_minutes,
 	    xp = EXCLUDED.xp,
 	    telegram_handle = EXCLUDED.telegram_handle,
 	    is_admin = EXCLUDED.is_admin,
+	    timezone = EXCLUDED.timezone,
+	    streak_freezes = EXCLUDED.streak_freezes,
+	    streak_start_date = EXCLUDED.streak_start_date,
+	    streak_freeze_milestone = EXCLUDED.streak_freeze_milestone,
 	    updated_at = NOW();
 	`
 
@@ -49,6 +53,11 @@ func (r *PostgresUserRepo) Save(u *user.User) error {
 		u.XP,
 		u.TelegramHandle,
 		u.IsAdmin,
+		timezoneOrDefault(u.Timezone),
+		u.StreakFreezes,
+		u.SessionVersion,
+		u.StreakStartDate,
+		u.StreakFreezeMilestone,
 	)
 
 	if err != nil {
@@ -62,13 +71,56 @@ func (r *PostgresUserRepo) Save(u *user.User) error {
 	return nil
 }
 
+// ========================================
+// Update password (ends every session)
+// ========================================
+func (r *PostgresUserRepo) UpdatePassword(id, passwordHash string) error {
+	const q = `
+	UPDATE users
+	SET password_hash = $2, session_version = session_version + 1, updated_at = NOW()
+	WHERE id = $1;
+	`
+
+	res, err := r.db.Exec(q, id, passwordHash)
+	if err != nil {
+		log.Printf("[PostgresUserRepo.UpdatePassword] SQL ERROR: %v", err)
+		return core.New(core.ServerError, "failed to update password")
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return core.New(core.NotFoundError, "user not found")
+	}
+	return nil
+}
+
+// ========================================
+// Update profile fields only
+// ========================================
+func (r *PostgresUserRepo) UpdateProfile(u *user.User) error {
+	const q = `
+	UPDATE users
+	SET display_name = $2, telegram_handle = $3, timezone = $4, updated_at = NOW()
+	WHERE id = $1;
+	`
+
+	res, err := r.db.Exec(q, u.ID, u.DisplayName, u.TelegramHandle, timezoneOrDefault(u.Timezone))
+	if err != nil {
+		log.Printf("[PostgresUserRepo.UpdateProfile] SQL ERROR: %v", err)
+		return core.New(core.ServerError, "failed to update profile")
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return core.New(core.NotFoundError, "user not found")
+	}
+	return nil
+}
+
 // ========================================
 // Get user by ID
 // ========================================
 func (r *PostgresUserRepo) Get(id string) (*user.User, error) {
 	const q = `
 	SELECT id, email, display_name, password_hash,
-	       streak_current_days, streak_last_date, total_minutes, xp, telegram_handle, is_admin
+	       streak_current_days, streak_last_date, total_minutes, xp, telegram_handle, is_admin, timezone, streak_freezes, session_version,
+	       streak_start_date, streak_freeze_milestone
 	FROM users
 	WHERE id = $1;
 	`
@@ -91,6 +143,11 @@ func (r *PostgresUserRepo) Get(id string) (*user.User, error) {
 		&u.XP,
 		&u.TelegramHandle,
 		&u.IsAdmin,
+		&u.Timezone,
+		&u.StreakFreezes,
+		&u.SessionVersion,
+		&u.StreakStartDate,
+		&u.StreakFreezeMilestone,
 	)
 
 	// null → zero
@@ -115,7 +172,8 @@ func (r *PostgresUserRepo) Get(id string) (*user.User, error) {
 func (r *PostgresUserRepo) FindByEmail(email string) (*user.User, error) {
 	const q = `
 	SELECT id, email, display_name, password_hash,
-	       streak_current_days, streak_last_date, total_minutes, xp, telegram_handle, is_admin
+	       streak_current_days, streak_last_date, total_minutes, xp, telegram_handle, is_admin, timezone, streak_freezes, session_version,
+	       streak_start_date, streak_freeze_milestone
 	FROM users
 	WHERE email = $1;
 	`
@@ -138,6 +196,11 @@ func (r *PostgresUserRepo) FindByEmail(email string) (*user.User, error) {
 		&u.XP,
 		&u.TelegramHandle,
 		&u.IsAdmin,
+		&u.Timezone,
+		&u.StreakFreezes,
+		&u.SessionVersion,
+		&u.StreakStartDate,
+		&u.StreakFreezeMilestone,
 	)
 
 	if streakLastDate != nil {
@@ -159,7 +222,7 @@ func (r *PostgresUserRepo) FindByEmail(email string) (*user.User, error) {
 // Check if email exists
 // ========================================
 func (r *PostgresUserRepo) ListAll() ([]*user.User, error) {
-	const q = `SELECT id, email, display_name, password_hash, streak_current_days, streak_last_date, total_minutes, xp, telegram_handle, is_admin FROM users ORDER BY created_at DESC;`
+	const q = `SELECT id, email, display_name, password_hash, streak_current_days, streak_last_date, total_minutes, xp, telegram_handle, is_admin, timezone, streak_freezes, session_version, streak_start_date, streak_freeze_milestone FROM users ORDER BY created_at DESC;`
 	rows, err := r.db.Query(q)
 	if err != nil {
 		return nil, core.New(core.ServerError, "failed to list users")
@@ -169,7 +232,7 @@ func (r *PostgresUserRepo) ListAll() ([]*user.User, error) {
 	for rows.Next() {
 		var u user.User
 		var streakLastDate *time.Time
-		if err := rows.Scan(&u.ID, &u.Email, &u.DisplayName, &u.PasswordHash, &u.StreakCurrentDays, &streakLastDate, &u.TotalMinutes, &u.XP, &u.TelegramHandle, &u.IsAdmin); err != nil {
+		if err := rows.Scan(&u.ID, &u.Email, &u.DisplayName, &u.PasswordHash, &u.StreakCurrentDays, &streakLastDate, &u.TotalMinutes, &u.XP, &u.TelegramHandle, &u.IsAdmin, &u.Timezone, &u.StreakFreezes, &u.SessionVersion, &u.StreakStartDate, &u.StreakFreezeMilestone); err != nil {
 			continue
 		}
 		if streakLastDate != nil {
@@ -188,6 +251,13 @@ func (r *PostgresUserRepo) Delete(id string) error {
 	return nil
 }
 
+func timezoneOrDefault(tz string) string {
+	if tz == "" {
+		return user.DefaultTimezone
+	}
+	return tz
+}
+
 func (r *PostgresUserRepo) Exists(email string) (bool, error) {
 	const q = `SELECT 1 FROM users WHERE email = $1 LIMIT 1;`
 
@@ -0,0 +1,65 @@
+package postgres
+
+import (
+	"database/sql"
+
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/user"
+)
+
+type PostgresXPLedgerRepo struct {
+	db dbtx
+}
+
+func NewPostgresXPLedgerRepo(db *sql.DB) *PostgresXPLedgerRepo {
+	return &PostgresXPLedgerRepo{db: db}
+}
+
+func (r *PostgresXPLedgerRepo) Append(t *user.XPTransaction) error {
+	const q = `
+	INSERT INTO xp_transactions (id, user_id, amount, reason, competition_id, season_id, achievement_id, created_at)
+	VALUES ($1,$2,$3,$4,$5,$6,$7,$8);
+	`
+
+	_, err := r.db.Exec(q, t.ID, t.UserID, t.Amount, string(t.Reason),
+		nullableString(t.Source.CompetitionID), nullableString(t.Source.SeasonID), nullableString(t.Source.AchievementID), t.CreatedAt)
+	if err != nil {
+		return core.New(core.ServerError, "failed to save xp transaction")
+	}
+	return nil
+}
+
+func (r *PostgresXPLedgerRepo) ListByUser(userID string) ([]*user.XPTransaction, error) {
+	const q = `
+	SELECT id, user_id, amount, reason, competition_id, season_id, achievement_id, created_at
+	FROM xp_transactions
+	WHERE user_id = $1
+	ORDER BY created_at DESC, id;
+	`
+
+	rows, err := r.db.Query(q, userID)
+	if err != nil {
+		return nil, core.New(core.ServerError, "failed to load xp history")
+	}
+	defer rows.Close()
+
+	var list []*user.XPTransaction
+	for rows.Next() {
+		var (
+			t                                      user.XPTransaction
+			reason                                 string
+			competitionID, seasonID, achievementID sql.NullString
+		)
+		if err := rows.Scan(&t.ID, &t.UserID, &t.Amount, &reason, &competitionID, &seasonID, &achievementID, &t.CreatedAt); err != nil {
+			return nil, core.New(core.ServerError, "failed to scan xp transaction")
+		}
+		t.Reason = user.XPReason(reason)
+		t.Source = user.XPSource{
+			CompetitionID: competitionID.String,
+			SeasonID:      seasonID.String,
+			AchievementID: achievementID.String,
+		}
+		list = append(list, &t)
+	}
+	return list, nil
+}
@@ -0,0 +1,119 @@
+package postgres
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/xp"
+)
+
+type PostgresXPPolicyRepo struct {
+	db dbtx
+}
+
+func NewPostgresXPPolicyRepo(db *sql.DB) *PostgresXPPolicyRepo {
+	return &PostgresXPPolicyRepo{db: db}
+}
+
+const xpPolicyColumns = `version, first_place_xp, second_place_xp, third_place_xp, top_half_xp, bottom_half_xp,
+	per_day_xp, perfect_streak_xp, season_first_xp, season_second_xp, season_third_xp, season_top_half_xp,
+	season_bottom_half_xp, season_win_xp, levels, created_by, created_at`
+
+// levelJSON is how a level is stored in the levels column.
+type levelJSON struct {
+	Name string `json:"name"`
+	XP   int    `json:"xp"`
+}
+
+func scanXPPolicy(row interface{ Scan(dest ...any) error }) (*xp.Policy, error) {
+	var p xp.Policy
+	var levels []byte
+	var createdBy sql.NullString
+	err := row.Scan(&p.Version, &p.FirstPlaceXP, &p.SecondPlaceXP, &p.ThirdPlaceXP, &p.TopHalfXP, &p.BottomHalfXP,
+		&p.PerDayXP, &p.PerfectStreakXP, &p.SeasonFirstXP, &p.SeasonSecondXP, &p.SeasonThirdXP, &p.SeasonTopHalfXP,
+		&p.SeasonBottomHalfXP, &p.SeasonWinXP, &levels, &createdBy, &p.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	var stored []levelJSON
+	if err := json.Unmarshal(levels, &stored); err != nil {
+		return nil, err
+	}
+	for _, l := range stored {
+		p.Levels = append(p.Levels, xp.Level{Name: l.Name, XP: l.XP})
+	}
+	p.CreatedBy = createdBy.String
+	return &p, nil
+}
+
+func (r *PostgresXPPolicyRepo) Current() (*xp.Policy, error) {
+	q := `SELECT ` + xpPolicyColumns + ` FROM xp_policies ORDER BY version DESC LIMIT 1;`
+
+	p, err := scanXPPolicy(r.db.QueryRow(q))
+	if err == sql.ErrNoRows {
+		return nil, core.New(core.NotFoundError, "xp policy not found")
+	}
+	if err != nil {
+		return nil, core.New(core.ServerError, "failed to load xp policy")
+	}
+	return p, nil
+}
+
+func (r *PostgresXPPolicyRepo) Get(version int) (*xp.Policy, error) {
+	q := `SELECT ` + xpPolicyColumns + ` FROM xp_policies WHERE version = $1;`
+
+	p, err := scanXPPolicy(r.db.QueryRow(q, version))
+	if err == sql.ErrNoRows {
+		return nil, core.New(core.NotFoundError, "xp policy not found")
+	}
+	if err != nil {
+		return nil, core.New(core.ServerError, "failed to load xp policy")
+	}
+	return p, nil
+}
+
+func (r *PostgresXPPolicyRepo) List() ([]*xp.Policy, error) {
+	q := `SELECT ` + xpPolicyColumns + ` FROM xp_policies ORDER BY version DESC;`
+
+	rows, err := r.db.Query(q)
+	if err != nil {
+		return nil, core.New(core.ServerError, "failed to load xp policies")
+	}
+	defer rows.Close()
+
+	var list []*xp.Policy
+	for rows.Next() {
+		p, err := scanXPPolicy(rows)
+		if err != nil {
+			return nil, core.New(core.ServerError, "failed to scan xp policy")
+		}
+		list = append(list, p)
+	}
+	return list, nil
+}
+
+func (r *PostgresXPPolicyRepo) Create(p *xp.Policy) error {
+	levels := make([]levelJSON, 0, len(p.Levels))
+	for _, l := range p.Levels {
+		levels = append(levels, levelJSON{Name: l.Name, XP: l.XP})
+	}
+	raw, err := json.Marshal(levels)
+	if err != nil {
+		return core.New(core.ServerError, "failed to encode levels")
+	}
+
+	const q = `
+	INSERT INTO xp_policies (` + xpPolicyColumns + `)
+	VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17);
+	`
+
+	_, err = r.db.Exec(q, p.Version, p.FirstPlaceXP, p.SecondPlaceXP, p.ThirdPlaceXP, p.TopHalfXP, p.BottomHalfXP,
+		p.PerDayXP, p.PerfectStreakXP, p.SeasonFirstXP, p.SeasonSecondXP, p.SeasonThirdXP, p.SeasonTopHalfXP,
+		p.SeasonBottomHalfXP, p.SeasonWinXP, raw, nullableString(p.CreatedBy), p.CreatedAt)
+	if err != nil {
+		return core.New(core.ServerError, "failed to save xp policy")
+	}
+	return nil
+}
@@ -36,10 +36,18 @@ func (r *RedisLeaderboard) key(competitionID string) string {
 	return fmt.Sprintf("leaderboard:cmp:%s", competitionID)
 }
 
+func (r *RedisLeaderboard) teamKey(competitionID string) string {
+	return fmt.Sprintf("leaderboard:cmp:%s:teams", competitionID)
+}
+
 func (r *RedisLeaderboard) AddScore(ctx context.Context, competitionID string, userID string, delta float64) (float64, error) {
 	return r.client.ZIncrBy(ctx, r.key(competitionID), delta, userID).Result()
 }
 
+func (r *RedisLeaderboard) SetScore(ctx context.Context, competitionID string, userID string, score float64) error {
+	return r.client.ZAdd(ctx, r.key(competitionID), redis.Z{Score: score, Member: userID}).Err()
+}
+
 func (r *RedisLeaderboard) GetTop(ctx context.Context, competitionID string, limit int) ([]ports.LeaderboardEntry, error) {
 	results, err := r.client.ZRevRangeWithScores(ctx, r.key(competitionID), 0, int64(limit-1)).Result()
 	if err != nil {
@@ -73,3 +81,69 @@ func (r *RedisLeaderboard) GetRank(ctx context.Context, competitionID string, us
 
 	return rank, score, nil
 }
+
+func (r *RedisLeaderboard) RemoveMember(ctx context.Context, competitionID string, userID string) error {
+	return r.client.ZRem(ctx, r.key(competitionID), userID).Err()
+}
+
+func (r *RedisLeaderboard) AddTeamScore(ctx context.Context, competitionID string, teamID string, delta float64) (float64, error) {
+	return r.client.ZIncrBy(ctx, r.teamKey(competitionID), delta, teamID).Result()
+}
+
+func (r *RedisLeaderboard) SetTeamScore(ctx context.Context, competitionID string, teamID string, score float64) error {
+	return r.client.ZAdd(ctx, r.teamKey(competitionID), redis.Z{Score: score, Member: teamID}).Err()
+}
+
+func (r *RedisLeaderboard) GetTopTeams(ctx context.Context, competitionID string, limit int) ([]ports.TeamLeaderboardEntry, error) {
+	results, err := r.client.ZRevRangeWithScores(ctx, r.teamKey(competitionID), 0, int64(limit-1)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]ports.TeamLeaderboardEntry, 0, len(results))
+	for _, z := range results {
+		out = append(out, ports.TeamLeaderboardEntry{
+			TeamID: z.Member.(string),
+			Score:  z.Score,
+		})
+	}
+
+	return out, nil
+}
+
+func (r *RedisLeaderboard) Clear(ctx context.Context, competitionID string) error {
+	return r.client.Del(ctx, r.key(competitionID), r.teamKey(competitionID)).Err()
+}
+
+// Rebuild writes the new boards under temporary keys and renames them over
+// the live ones in one transaction, so readers never see a half-built board.
+func (r *RedisLeaderboard) Rebuild(ctx context.Context, competitionID string, entries []ports.LeaderboardEntry, teams []ports.TeamLeaderboardEntry) error {
+	key, teamKey := r.key(competitionID), r.teamKey(competitionID)
+
+	members := make([]redis.Z, 0, len(entries))
+	for _, e := range entries {
+		members = append(members, redis.Z{Score: e.Score, Member: e.UserID})
+	}
+	teamMembers := make([]redis.Z, 0, len(teams))
+	for _, t := range teams {
+		teamMembers = append(teamMembers, redis.Z{Score: t.Score, Member: t.TeamID})
+	}
+
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		replaceSortedSet(ctx, pipe, key, members)
+		replaceSortedSet(ctx, pipe, teamKey, teamMembers)
+		return nil
+	})
+	return err
+}
+
+func replaceSortedSet(ctx context.Context, pipe redis.Pipeliner, key string, members []redis.Z) {
+	if len(members) == 0 {
+		pipe.Del(ctx, key)
+		return
+	}
+	tmp := key + ":rebuild"
+	pipe.Del(ctx, tmp)
+	pipe.ZAdd(ctx, tmp, members...)
+	pipe.Rename(ctx, tmp, key)
+}
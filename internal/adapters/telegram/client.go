@@ -0,0 +1,131 @@
+package telegram
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// DefaultAPIURL is the public Bot API; tests point the client at a local server instead.
+const DefaultAPIURL = "https://api.telegram.org"
+
+// Client talks to the Telegram Bot API over HTTP.
+type Client struct {
+	baseURL string // e.g. https://api.telegram.org/bot<token>
+	http    *http.Client
+}
+
+func NewClient(apiURL, token string) *Client {
+	if apiURL == "" {
+		apiURL = DefaultAPIURL
+	}
+	return &Client{
+		baseURL: strings.TrimRight(apiURL, "/") + "/bot" + token,
+		http:    &http.Client{Timeout: 70 * time.Second}, // above the longest long-poll
+	}
+}
+
+// apiResponse is the envelope of every Bot API reply.
+type apiResponse struct {
+	OK          bool            `json:"ok"`
+	Description string          `json:"description"`
+	Result      json.RawMessage `json:"result"`
+}
+
+type apiUpdate struct {
+	UpdateID int64 `json:"update_id"`
+	Message  *struct {
+		Text string `json:"text"`
+		Chat struct {
+			ID   int64  `json:"id"`
+			Type string `json:"type"`
+		} `json:"chat"`
+		From *struct {
+			ID       int64  `json:"id"`
+			Username string `json:"username"`
+		} `json:"from"`
+	} `json:"message"`
+}
+
+func (c *Client) GetUpdates(ctx context.Context, offset int64, timeout time.Duration) ([]ports.TelegramUpdate, error) {
+	q := url.Values{}
+	q.Set("offset", strconv.FormatInt(offset, 10))
+	q.Set("timeout", strconv.Itoa(int(timeout.Seconds())))
+	q.Set("allowed_updates", `["message"]`)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/getUpdates?"+q.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw []apiUpdate
+	if err := c.do(req, &raw); err != nil {
+		return nil, err
+	}
+
+	updates := make([]ports.TelegramUpdate, 0, len(raw))
+	for _, u := range raw {
+		up := ports.TelegramUpdate{UpdateID: u.UpdateID}
+		if u.Message != nil {
+			up.ChatID = u.Message.Chat.ID
+			up.Private = u.Message.Chat.Type == "private"
+			up.Text = u.Message.Text
+			if u.Message.From != nil {
+				up.FromID = u.Message.From.ID
+				up.Username = u.Message.From.Username
+			}
+		}
+		updates = append(updates, up)
+	}
+	return updates, nil
+}
+
+func (c *Client) SendMessage(ctx context.Context, chatID int64, text string) error {
+	body, err := json.Marshal(map[string]any{"chat_id": chatID, "text": text})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/sendMessage", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	return c.do(req, nil)
+}
+
+// do sends the request and decodes the result into out (if not nil).
+func (c *Client) do(req *http.Request, out any) error {
+	resp, err := c.http.Do(req)
+	if err != nil {
+		// the request URL carries the bot token, keep it out of logs
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return fmt.Errorf("telegram: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var r apiResponse
+	if err := json.NewDecoder(resp.Body).Decode(&r); err != nil {
+		return fmt.Errorf("telegram: bad response (HTTP %d): %w", resp.StatusCode, err)
+	}
+	if !r.OK {
+		return fmt.Errorf("telegram: %s (HTTP %d)", r.Description, resp.StatusCode)
+	}
+	if out != nil {
+		return json.Unmarshal(r.Result, out)
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package achievement
+
+import (
+	"time"
+
+	appXP "github.com/bakhtybayevn/powerbook/internal/application/xp"
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/achievement"
+	"github.com/bakhtybayevn/powerbook/internal/domain/competition"
+	"github.com/bakhtybayevn/powerbook/internal/domain/user"
+	"github.com/bakhtybayevn/powerbook/internal/domain/xp"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// Evaluate unlocks every achievement the user has reached but not yet
+// collected and grants their XP rewards. It runs inside the caller's unit of
+// work, after the change that may have unlocked something is saved, and
+// returns the definitions unlocked by this call and the levels their XP reached.
+func Evaluate(tx ports.Repositories, userID string) ([]achievement.Definition, []*xp.LevelUp, error) {
+	have, err := tx.Achievements.ListByUser(userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	unlocked := make(map[string]bool, len(have))
+	for _, a := range have {
+		unlocked[a.AchievementID] = true
+	}
+	if len(unlocked) == len(achievement.Catalog()) {
+		return nil, nil, nil
+	}
+
+	u, err := tx.Users.Get(userID)
+	if err != nil {
+		return nil, nil, core.New(core.NotFoundError, "user not found")
+	}
+
+	progress := achievement.Progress{
+		TotalMinutes: u.TotalMinutes,
+		StreakDays:   u.StreakCurrentDays,
+	}
+	// the competition lookups are only worth it while their badges are locked
+	if !unlocked[achievement.FirstWin] {
+		if progress.CompetitionWins, err = competitionWins(tx.Competitions, userID); err != nil {
+			return nil, nil, err
+		}
+	}
+	if !unlocked[achievement.FirstGiftOut] {
+		if progress.GiftsGiven, err = giftsGiven(tx.Competitions, userID); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var earned []achievement.Definition
+	now := time.Now().UTC()
+	for _, d := range achievement.Reached(progress, unlocked) {
+		// a concurrent evaluation may have got there first; only the one that
+		// records the unlock grants the reward
+		ok, err := tx.Achievements.Unlock(&achievement.Unlock{UserID: userID, AchievementID: d.ID, UnlockedAt: now})
+		if err != nil {
+			return nil, nil, err
+		}
+		if !ok {
+			continue
+		}
+		u.AddXP(d.XPReward, user.XPAchievement, user.XPSource{AchievementID: d.ID})
+		earned = append(earned, d)
+	}
+
+	if len(earned) == 0 {
+		return nil, nil, nil
+	}
+	levelUps, err := appXP.SaveUser(tx, u)
+	if err != nil {
+		return nil, nil, err
+	}
+	return earned, levelUps, nil
+}
+
+// competitionWins counts closed competitions, with at least two
+// participants, that the user won.
+func competitionWins(repo ports.CompetitionRepository, userID string) (int, error) {
+	comps, err := repo.FindByUser(userID)
+	if err != nil {
+		return 0, err
+	}
+
+	wins := 0
+	for _, cmp := range comps {
+		if cmp.Status != competition.StatusClosed {
+			continue
+		}
+		results, err := repo.GetResults(cmp.ID)
+		if err != nil {
+			return 0, err
+		}
+		if len(results) < 2 {
+			continue
+		}
+		for _, r := range results {
+			if r.UserID == userID && r.Won() {
+				wins++
+			}
+		}
+	}
+	return wins, nil
+}
+
+func giftsGiven(repo ports.CompetitionRepository, userID string) (int, error) {
+	gifts, err := repo.GetUserGiftHistory(userID)
+	if err != nil {
+		return 0, err
+	}
+
+	given := 0
+	for _, g := range gifts {
+		if g.GiverID == userID && g.GiverConfirmed {
+			given++
+		}
+	}
+	return given, nil
+}
+
+// Evaluator runs Evaluate in a unit of work of its own, for callers that
+// don't have one.
+type Evaluator struct {
+	UoW ports.UnitOfWork
+}
+
+func NewEvaluator(uow ports.UnitOfWork) *Evaluator {
+	return &Evaluator{UoW: uow}
+}
+
+func (e *Evaluator) Evaluate(userID string) ([]achievement.Definition, []*xp.LevelUp, error) {
+	var (
+		earned   []achievement.Definition
+		levelUps []*xp.LevelUp
+	)
+	err := e.UoW.Do(func(tx ports.Repositories) error {
+		var err error
+		earned, levelUps, err = Evaluate(tx, userID)
+		return err
+	})
+	return earned, levelUps, err
+}
@@ -0,0 +1,52 @@
+package book
+
+import (
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/book"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+type AddBookCommand struct {
+	Title     string
+	Author    string
+	ISBN      string
+	PageCount int
+	CoverURL  string
+}
+
+type AddBookHandler struct {
+	Repo ports.BookRepository
+}
+
+func NewAddBookHandler(repo ports.BookRepository) *AddBookHandler {
+	return &AddBookHandler{Repo: repo}
+}
+
+// Handle adds a book to the catalog. If a book with the same ISBN already
+// exists, the existing entry is returned instead of creating a duplicate.
+func (h *AddBookHandler) Handle(cmd AddBookCommand) (*book.Book, error) {
+	// === VALIDATION ===
+	if len(cmd.Title) > 256 {
+		return nil, core.New(core.ValidationError, "title too long")
+	}
+	if !book.ValidISBN(cmd.ISBN) {
+		return nil, core.New(core.ValidationError, "isbn must have 10 or 13 characters")
+	}
+
+	if cmd.ISBN != "" {
+		if existing, err := h.Repo.FindByISBN(cmd.ISBN); err == nil {
+			return existing, nil
+		}
+	}
+
+	b, err := book.NewBook(cmd.Title, cmd.Author, cmd.ISBN, cmd.PageCount, cmd.CoverURL)
+	if err != nil {
+		return nil, core.New(core.ValidationError, err.Error())
+	}
+
+	if err := h.Repo.Save(b); err != nil {
+		return nil, core.New(core.ServerError, "failed to save book")
+	}
+
+	return b, nil
+}
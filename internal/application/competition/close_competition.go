@@ -4,22 +4,31 @@ import (
 	"math/rand"
 	"sort"
 
+	appAchievement "github.com/bakhtybayevn/powerbook/internal/application/achievement"
+	appXP "github.com/bakhtybayevn/powerbook/internal/application/xp"
 	"github.com/bakhtybayevn/powerbook/internal/core"
 	"github.com/bakhtybayevn/powerbook/internal/domain/competition"
+	"github.com/bakhtybayevn/powerbook/internal/domain/notification"
+	"github.com/bakhtybayevn/powerbook/internal/domain/user"
+	"github.com/bakhtybayevn/powerbook/internal/domain/xp"
 	"github.com/bakhtybayevn/powerbook/internal/ports"
 )
 
 type CloseCompetitionCommand struct {
 	CompetitionID string
+	ActorID       string // empty when the auto-close scheduler closes an ended competition
 }
 
+// CloseCompetitionHandler awards XP, pairs gifts and marks the competition
+// closed in one transaction, so a failure halfway leaves nothing behind.
 type CloseCompetitionHandler struct {
-	Repo     ports.CompetitionRepository
-	UserRepo ports.UserRepository
+	UoW      ports.UnitOfWork
+	Policies ports.XPPolicyRepository
+	Notifier ports.Notifier // optional
 }
 
-func NewCloseCompetitionHandler(repo ports.CompetitionRepository, userRepo ports.UserRepository) *CloseCompetitionHandler {
-	return &CloseCompetitionHandler{Repo: repo, UserRepo: userRepo}
+func NewCloseCompetitionHandler(uow ports.UnitOfWork, policies ports.XPPolicyRepository, notifier ports.Notifier) *CloseCompetitionHandler {
+	return &CloseCompetitionHandler{UoW: uow, Policies: policies, Notifier: notifier}
 }
 
 type Winner struct {
@@ -30,48 +39,27 @@ type Winner struct {
 	Rank         int    `json:"rank"`
 	XPEarned     int    `json:"xp_earned"`
 	Group        string `json:"group"` // "top", "bottom", or "neutral"
-}
 
-// XP award logic:
-// - 1st place: 200 XP
-// - 2nd place: 150 XP
-// - 3rd place: 100 XP
-// - Top 50%: 50 XP
-// - Bottom 50%: 20 XP (participation)
-// - Bonus: +5 XP per day read during competition
-// - Streak bonus: +30 XP if read every day of competition
-func calculateXP(rank, total, daysRead int, compDays int) int {
-	xp := 0
-
-	// Position-based XP
-	switch rank {
-	case 1:
-		xp += 200
-	case 2:
-		xp += 150
-	case 3:
-		xp += 100
-	default:
-		mid := total / 2
-		if total%2 != 0 {
-			mid++
-		}
-		if rank <= mid {
-			xp += 50 // top 50%
-		} else {
-			xp += 20 // bottom 50%
-		}
-	}
+	// team competitions only: XP and group follow the team's placement
+	TeamID   string `json:"team_id,omitempty"`
+	TeamName string `json:"team_name,omitempty"`
+	TeamRank int    `json:"team_rank,omitempty"`
 
-	// Per-day bonus
-	xp += daysRead * 5
+	// levels the XP of this close reached; empty when replaying a past close
+	LevelUps []*xp.LevelUp `json:"-"`
+}
 
-	// Perfect streak bonus (read every day of competition)
-	if compDays > 0 && daysRead >= compDays {
-		xp += 30
+// groupForPosition splits a ranking into top 50%, bottom 50% and neutral
+// (middle position if odd). For odd total: exactly half rounded down on each
+// side, the middle one is neutral. i is the 0-based position.
+func groupForPosition(i, total int) string {
+	halfSize := total / 2
+	if total%2 != 0 && i == halfSize {
+		return "neutral"
+	} else if i < halfSize {
+		return "top"
 	}
-
-	return xp
+	return "bottom"
 }
 
 func (h *CloseCompetitionHandler) Handle(cmd CloseCompetitionCommand) ([]Winner, []*competition.GiftExchange, error) {
@@ -79,65 +67,103 @@ func (h *CloseCompetitionHandler) Handle(cmd CloseCompetitionCommand) ([]Winner,
 		return nil, nil, core.New(core.ValidationError, "competition id is required")
 	}
 
-	cmp, err := h.Repo.Get(cmd.CompetitionID)
+	// XP is awarded under the policy in force when the close starts
+	policy, err := h.Policies.Current()
 	if err != nil {
-		return nil, nil, core.New(core.NotFoundError, "competition not found")
+		return nil, nil, err
 	}
 
-	if cmp.Status == competition.StatusClosed {
-		return nil, nil, core.New(core.ValidationError, "competition already closed")
+	var out *closeOutcome
+	err = h.UoW.Do(func(tx ports.Repositories) error {
+		var err error
+		out, err = closeCompetition(tx, cmd, *policy)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
 	}
 
-	// Mark closed
-	cmp.Status = competition.StatusClosed
+	// tell participants once the close has committed; retries stay quiet
+	if len(out.notifications) > 0 && h.Notifier != nil {
+		h.Notifier.Notify(out.notifications...)
+	}
+	return out.winners, out.gifts, nil
+}
 
-	// Build sorted standings
-	winners := make([]Winner, 0, len(cmp.Participants))
-	for _, p := range cmp.Participants {
-		winners = append(winners, Winner{
-			UserID:       p.UserID,
-			Points:       p.Points,
-			DaysRead:     p.DaysRead,
-			MinutesTotal: p.MinutesTotal,
-		})
+// closeOutcome is what closeCompetition did. notifications is empty when an
+// earlier close was replayed and nothing new was awarded.
+type closeOutcome struct {
+	winners       []Winner
+	gifts         []*competition.GiftExchange
+	notifications []*notification.Notification
+}
+
+func closeCompetition(tx ports.Repositories, cmd CloseCompetitionCommand, policy xp.Policy) (*closeOutcome, error) {
+	cmp, err := tx.Competitions.Get(cmd.CompetitionID)
+	if err != nil {
+		return nil, core.New(core.NotFoundError, "competition not found")
 	}
 
-	sort.Slice(winners, func(i, j int) bool {
-		return winners[i].Points > winners[j].Points
-	})
+	if cmd.ActorID != "" {
+		if err := requireOrganizer(cmp, cmd.ActorID, tx.Users); err != nil {
+			return nil, err
+		}
+	}
 
-	// Calculate competition duration in days
-	compDays := int(cmp.EndDate.Sub(cmp.StartDate).Hours()/24) + 1
+	switch cmp.Status {
+	case competition.StatusCancelled:
+		return nil, core.New(core.ValidationError, "competition was cancelled")
+	case competition.StatusClosed:
+		// a retry gets the outcome of the original close
+		return closedResults(tx, cmp)
+	}
 
-	// Assign ranks and XP
+	// Claim the close. Only one caller can move the competition out of
+	// "open"; a concurrent closer waits on the row until we commit and then
+	// finds it closed, so XP and gifts are awarded exactly once.
+	claimed, err := tx.Competitions.TransitionStatus(cmp.ID, competition.StatusOpen, competition.StatusClosed)
+	if err != nil {
+		return nil, core.New(core.ServerError, "failed to save competition")
+	}
+	if !claimed {
+		if cmp, err = tx.Competitions.Get(cmp.ID); err != nil {
+			return nil, core.New(core.NotFoundError, "competition not found")
+		}
+		if cmp.Status != competition.StatusClosed {
+			return nil, core.New(core.ValidationError, "competition is no longer open")
+		}
+		return closedResults(tx, cmp)
+	}
+	cmp.Status = competition.StatusClosed
+	cmp.XPPolicyVersion = policy.Version
+	if err := tx.Competitions.SetXPPolicyVersion(cmp.ID, policy.Version); err != nil {
+		return nil, core.New(core.ServerError, "failed to save competition")
+	}
+
+	winners := rankWinners(cmp, policy)
 	total := len(winners)
-	for i := range winners {
-		winners[i].Rank = i + 1
-		winners[i].XPEarned = calculateXP(i+1, total, winners[i].DaysRead, compDays)
 
-		// Award XP to user
-		u, err := h.UserRepo.Get(winners[i].UserID)
-		if err == nil {
-			u.AddXP(winners[i].XPEarned)
-			h.UserRepo.Save(u)
-		}
+	// freeze the final standings
+	if err := tx.Competitions.SaveResults(cmp.ID, resultsOf(winners)); err != nil {
+		return nil, err
 	}
 
-	// Assign groups: top 50%, bottom 50%, neutral (middle person if odd)
-	// For odd total: exactly half rounded down on each side, middle person is neutral
-	halfSize := total / 2
+	// Award XP to users
 	for i := range winners {
-		if total%2 != 0 && i == halfSize {
-			winners[i].Group = "neutral"
-		} else if i < halfSize {
-			winners[i].Group = "top"
-		} else if total%2 != 0 && i > halfSize {
-			winners[i].Group = "bottom"
-		} else if total%2 == 0 && i >= halfSize {
-			winners[i].Group = "bottom"
-		} else {
-			winners[i].Group = "top"
+		u, err := tx.Users.Get(winners[i].UserID)
+		if err != nil {
+			continue // deleted account
+		}
+		u.AddXP(winners[i].XPEarned, user.XPCompetition, user.XPSource{CompetitionID: cmp.ID})
+		levelUps, err := appXP.SaveUser(tx, u)
+		if err != nil {
+			return nil, err
+		}
+		_, badgeLevelUps, err := appAchievement.Evaluate(tx, u.ID)
+		if err != nil {
+			return nil, err
 		}
+		winners[i].LevelUps = append(levelUps, badgeLevelUps...)
 	}
 
 	// Generate gift pairings: top gives to bottom (1:1 random)
@@ -166,15 +192,80 @@ func (h *CloseCompetitionHandler) Handle(cmd CloseCompetitionCommand) ([]Winner,
 
 		for i := 0; i < pairCount; i++ {
 			g := competition.NewGiftExchange(cmp.ID, topHalf[i], bottomHalf[i])
-			if err := h.Repo.SaveGiftExchange(g); err == nil {
-				gifts = append(gifts, g)
+			if err := tx.Competitions.SaveGiftExchange(g); err != nil {
+				return nil, err
 			}
+			gifts = append(gifts, g)
 		}
 	}
 
-	if err := h.Repo.Save(cmp); err != nil {
-		return nil, nil, core.New(core.ServerError, "failed to save competition")
+	return &closeOutcome{
+		winners:       winners,
+		gifts:         gifts,
+		notifications: closeNotifications(tx.Users, cmp, winners, gifts),
+	}, nil
+}
+
+// rankWinners orders the participants by the competition's scoring rules
+// and assigns rank, XP (under policy) and gift group to each.
+func rankWinners(cmp *competition.Competition, policy xp.Policy) []Winner {
+	ranked := make([]*competition.Participant, 0, len(cmp.Participants))
+	for _, p := range cmp.Participants {
+		ranked = append(ranked, p)
 	}
 
-	return winners, gifts, nil
+	// full ties go to the lower user id so a retry ranks them the same way
+	sort.Slice(ranked, func(i, j int) bool {
+		if cmp.Rules.Ranks(ranked[i], ranked[j]) {
+			return true
+		}
+		if cmp.Rules.Ranks(ranked[j], ranked[i]) {
+			return false
+		}
+		return ranked[i].UserID < ranked[j].UserID
+	})
+
+	winners := make([]Winner, 0, len(ranked))
+	for _, p := range ranked {
+		winners = append(winners, Winner{
+			UserID:       p.UserID,
+			Points:       p.Points,
+			DaysRead:     p.DaysRead,
+			MinutesTotal: p.MinutesTotal,
+		})
+	}
+
+	// Calculate competition duration in days
+	compDays := int(cmp.EndDate.Sub(cmp.StartDate).Hours()/24) + 1
+
+	// Assign ranks, XP and groups
+	total := len(winners)
+	for i := range winners {
+		winners[i].Rank = i + 1
+	}
+
+	if cmp.IsTeamCompetition() {
+		assignTeamPlacements(cmp, winners, compDays, policy)
+	} else {
+		for i := range winners {
+			winners[i].XPEarned = policy.CompetitionXP(i+1, total, winners[i].DaysRead, compDays)
+			winners[i].Group = groupForPosition(i, total)
+		}
+	}
+
+	return winners
+}
+
+// closedResults returns the outcome of an earlier close without awarding
+// anything again: the stored standings and gift pairings.
+func closedResults(tx ports.Repositories, cmp *competition.Competition) (*closeOutcome, error) {
+	results, err := storedResults(tx.Competitions, cmp)
+	if err != nil {
+		return nil, err
+	}
+	gifts, err := tx.Competitions.GetGiftExchanges(cmp.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &closeOutcome{winners: winnersOf(results), gifts: gifts}, nil
 }
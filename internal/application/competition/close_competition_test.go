@@ -0,0 +1,295 @@
+package competition
+
+import (
+	"maps"
+	"testing"
+	"time"
+
+	"github.com/bakhtybayevn/powerbook/internal/adapters/memory"
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/competition"
+	"github.com/bakhtybayevn/powerbook/internal/domain/user"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// fixtureSeriesID is the series every fixture competition belongs to.
+const fixtureSeriesID = "fixture-series"
+
+// closeFixture is a ten-day competition on the in-memory adapters.
+type closeFixture struct {
+	repos ports.Repositories
+	cmp   *competition.Competition
+	users map[string]string // name -> user id
+	teams map[string]string // name -> team id
+}
+
+func newCloseFixture(t *testing.T, format competition.Format, teams ...string) *closeFixture {
+	t.Helper()
+
+	f := &closeFixture{
+		repos: ports.Repositories{
+			Users:        memory.NewUserRepo(),
+			Readings:     memory.NewReadingRepo(),
+			Competitions: memory.NewCompetitionRepo(),
+			Freezes:      memory.NewStreakFreezeRepo(),
+			XPLedger:     memory.NewXPLedgerRepo(),
+			Achievements: memory.NewAchievementRepo(),
+			XPPolicies:   memory.NewXPPolicyRepo(),
+			LevelUps:     memory.NewLevelUpRepo(),
+			Series:       memory.NewSeriesRepo(),
+		},
+		users: map[string]string{},
+		teams: map[string]string{},
+	}
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	cmp, err := competition.NewCompetition("Sprint", start, start.AddDate(0, 0, 9), competition.Rules{PointsPerMinute: 1})
+	if err != nil {
+		t.Fatalf("new competition: %v", err)
+	}
+	if err := cmp.SetFormat(format); err != nil {
+		t.Fatalf("set format: %v", err)
+	}
+	cmp.OwnerID = f.user(t, "owner")
+	cmp.SeriesID = fixtureSeriesID
+	if err := f.repos.Competitions.Create(cmp); err != nil {
+		t.Fatalf("create competition: %v", err)
+	}
+	f.cmp = cmp
+
+	for _, name := range teams {
+		team, err := competition.NewTeam(cmp.ID, name, "")
+		if err != nil {
+			t.Fatalf("new team: %v", err)
+		}
+		if err := f.repos.Competitions.SaveTeam(team); err != nil {
+			t.Fatalf("save team: %v", err)
+		}
+		f.teams[name] = team.ID
+	}
+	return f
+}
+
+func (f *closeFixture) user(t *testing.T, name string) string {
+	t.Helper()
+
+	u := user.NewUser(name+"@example.com", name, "secret123")
+	if err := f.repos.Users.Save(u); err != nil {
+		t.Fatalf("save user: %v", err)
+	}
+	f.users[name] = u.ID
+	return u.ID
+}
+
+// join adds a participant with final stats; team may be empty.
+func (f *closeFixture) join(t *testing.T, name, team string, points, daysRead int) {
+	t.Helper()
+
+	p := competition.NewParticipant(f.user(t, name))
+	p.TeamID = f.teams[team]
+	p.Points = points
+	p.MinutesTotal = points
+	p.DaysRead = daysRead
+	if err := f.repos.Competitions.SaveParticipant(f.cmp.ID, p); err != nil {
+		t.Fatalf("save participant: %v", err)
+	}
+}
+
+func (f *closeFixture) handler() *CloseCompetitionHandler {
+	return NewCloseCompetitionHandler(memory.NewUnitOfWork(f.repos), f.repos.XPPolicies, nil)
+}
+
+func (f *closeFixture) xp(t *testing.T) map[string]int {
+	t.Helper()
+
+	out := map[string]int{}
+	for name, id := range f.users {
+		u, err := f.repos.Users.Get(id)
+		if err != nil {
+			t.Fatalf("get user: %v", err)
+		}
+		out[name] = u.XP
+	}
+	return out
+}
+
+// placement is what a participant is expected to get from the close.
+type placement struct {
+	rank     int
+	xp       int
+	group    string
+	team     string
+	teamRank int
+}
+
+func TestCloseCompetition(t *testing.T) {
+	tests := []struct {
+		name      string
+		setup     func(t *testing.T) *closeFixture
+		actor     string // fixture user name; empty for the scheduler
+		wantErr   core.ErrorType
+		want      map[string]placement
+		wantGifts int
+	}{
+		{
+			name: "individual placements",
+			setup: func(t *testing.T) *closeFixture {
+				f := newCloseFixture(t, competition.FormatIndividual)
+				f.join(t, "ann", "", 300, 10)
+				f.join(t, "bob", "", 200, 5)
+				f.join(t, "cat", "", 100, 2)
+				return f
+			},
+			want: map[string]placement{
+				"ann": {rank: 1, xp: 200 + 10*5 + 30, group: "top"},
+				"bob": {rank: 2, xp: 150 + 5*5, group: "neutral"},
+				"cat": {rank: 3, xp: 100 + 2*5, group: "bottom"},
+			},
+			wantGifts: 1,
+		},
+		{
+			name: "team placement decides XP and gift groups",
+			setup: func(t *testing.T) *closeFixture {
+				f := newCloseFixture(t, competition.FormatTeam, "Owls", "Foxes", "Empty")
+				f.join(t, "ann", "Owls", 100, 10)
+				f.join(t, "amy", "Owls", 10, 1)
+				f.join(t, "bob", "Foxes", 80, 4)
+				f.join(t, "dan", "", 500, 3) // legacy member without a team
+				return f
+			},
+			want: map[string]placement{
+				"ann": {rank: 2, xp: 200 + 10*5 + 30, group: "top", team: "Owls", teamRank: 1},
+				"amy": {rank: 4, xp: 200 + 1*5, group: "top", team: "Owls", teamRank: 1},
+				"bob": {rank: 3, xp: 150 + 4*5, group: "bottom", team: "Foxes", teamRank: 2},
+				"dan": {rank: 1, xp: 20 + 3*5, group: "neutral"},
+			},
+			wantGifts: 1,
+		},
+		{
+			name: "organizer closes early",
+			setup: func(t *testing.T) *closeFixture {
+				f := newCloseFixture(t, competition.FormatIndividual)
+				f.join(t, "ann", "", 300, 10)
+				f.join(t, "bob", "", 200, 5)
+				return f
+			},
+			actor: "owner",
+			want: map[string]placement{
+				"ann": {rank: 1, xp: 200 + 10*5 + 30, group: "top"},
+				"bob": {rank: 2, xp: 150 + 5*5, group: "bottom"},
+			},
+			wantGifts: 1,
+		},
+		{
+			name: "only organizers can close",
+			setup: func(t *testing.T) *closeFixture {
+				f := newCloseFixture(t, competition.FormatIndividual)
+				f.join(t, "ann", "", 300, 10)
+				return f
+			},
+			actor:   "ann",
+			wantErr: core.AuthError,
+		},
+		{
+			name: "cancelled competitions stay cancelled",
+			setup: func(t *testing.T) *closeFixture {
+				f := newCloseFixture(t, competition.FormatIndividual)
+				f.join(t, "ann", "", 300, 10)
+				if _, err := f.repos.Competitions.TransitionStatus(f.cmp.ID, competition.StatusOpen, competition.StatusCancelled); err != nil {
+					t.Fatalf("cancel: %v", err)
+				}
+				return f
+			},
+			wantErr: core.ValidationError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := tt.setup(t)
+			cmd := CloseCompetitionCommand{CompetitionID: f.cmp.ID, ActorID: f.users[tt.actor]}
+			xpBefore := f.xp(t)
+
+			winners, gifts, err := f.handler().Handle(cmd)
+			if tt.wantErr != "" {
+				if !core.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %s", err, tt.wantErr)
+				}
+				if got := f.xp(t); !maps.Equal(got, xpBefore) {
+					t.Errorf("XP changed on a refused close: %v -> %v", xpBefore, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("close: %v", err)
+			}
+
+			checkPlacements(t, f, winners, tt.want)
+			if len(gifts) != tt.wantGifts {
+				t.Errorf("got %d gift pairings, want %d", len(gifts), tt.wantGifts)
+			}
+			for _, g := range gifts {
+				giver, receiver := placementOf(f, tt.want, g.GiverID), placementOf(f, tt.want, g.ReceiverID)
+				if giver.group != "top" || receiver.group != "bottom" {
+					t.Errorf("gift from a %q to a %q reader, want top to bottom", giver.group, receiver.group)
+				}
+			}
+
+			// XP went to everyone, at least what the placement earned
+			xpAfter := f.xp(t)
+			for name, p := range tt.want {
+				if got := xpAfter[name] - xpBefore[name]; got < p.xp {
+					t.Errorf("%s gained %d XP, want at least %d", name, got, p.xp)
+				}
+			}
+
+			// a retry reports the same outcome and awards nothing again
+			again, againGifts, err := f.handler().Handle(cmd)
+			if err != nil {
+				t.Fatalf("retry: %v", err)
+			}
+			checkPlacements(t, f, again, tt.want)
+			if len(againGifts) != len(gifts) {
+				t.Errorf("retry returned %d gift pairings, want %d", len(againGifts), len(gifts))
+			}
+			stored, err := f.repos.Competitions.GetGiftExchanges(f.cmp.ID)
+			if err != nil {
+				t.Fatalf("get gifts: %v", err)
+			}
+			if len(stored) != len(gifts) {
+				t.Errorf("%d gift pairings stored after the retry, want %d", len(stored), len(gifts))
+			}
+			if got := f.xp(t); !maps.Equal(got, xpAfter) {
+				t.Errorf("retry changed XP: %v -> %v", xpAfter, got)
+			}
+		})
+	}
+}
+
+func checkPlacements(t *testing.T, f *closeFixture, winners []Winner, want map[string]placement) {
+	t.Helper()
+
+	if len(winners) != len(want) {
+		t.Fatalf("got %d winners, want %d", len(winners), len(want))
+	}
+	for _, w := range winners {
+		p := placementOf(f, want, w.UserID)
+		got := placement{rank: w.Rank, xp: w.XPEarned, group: w.Group, team: w.TeamName, teamRank: w.TeamRank}
+		if got != p {
+			t.Errorf("%s placed %+v, want %+v", nameOf(f, w.UserID), got, p)
+		}
+	}
+}
+
+func placementOf(f *closeFixture, want map[string]placement, userID string) placement {
+	return want[nameOf(f, userID)]
+}
+
+func nameOf(f *closeFixture, userID string) string {
+	for name, id := range f.users {
+		if id == userID {
+			return name
+		}
+	}
+	return userID
+}
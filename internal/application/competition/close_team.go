@@ -0,0 +1,43 @@
+package competition
+
+import (
+	"github.com/bakhtybayevn/powerbook/internal/domain/competition"
+	"github.com/bakhtybayevn/powerbook/internal/domain/xp"
+)
+
+// assignTeamPlacements ranks teams by summed member points and gives every
+// member the XP and group of their team's placement. Gift pairing then works
+// unchanged: members of top-half teams give to members of bottom-half teams.
+func assignTeamPlacements(cmp *competition.Competition, winners []Winner, compDays int, policy xp.Policy) {
+	teamRank := map[string]int{}
+	numTeams := 0
+	for _, s := range cmp.TeamStandings() {
+		if len(s.Members) == 0 {
+			continue // empty teams don't take a place
+		}
+		numTeams++
+		teamRank[s.Team.ID] = numTeams
+	}
+
+	for i := range winners {
+		p := cmp.Participants[winners[i].UserID]
+		rank, ok := teamRank[p.TeamID]
+		if !ok {
+			// teamless member (joining requires a team, so only legacy rows):
+			// ranked below every team and below the podium, left out of gift pairing
+			last := numTeams + 1
+			if last < 4 {
+				last = 4
+			}
+			winners[i].XPEarned = policy.CompetitionXP(last, last, winners[i].DaysRead, compDays)
+			winners[i].Group = "neutral"
+			continue
+		}
+
+		winners[i].TeamID = p.TeamID
+		winners[i].TeamName = cmp.Teams[p.TeamID].Name
+		winners[i].TeamRank = rank
+		winners[i].XPEarned = policy.CompetitionXP(rank, numTeams, winners[i].DaysRead, compDays)
+		winners[i].Group = groupForPosition(rank-1, numTeams)
+	}
+}
@@ -0,0 +1,92 @@
+package competition
+
+import (
+	"fmt"
+
+	appAchievement "github.com/bakhtybayevn/powerbook/internal/application/achievement"
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/competition"
+	"github.com/bakhtybayevn/powerbook/internal/domain/notification"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+type ConfirmGiftCommand struct {
+	GiftID          string
+	UserID          string
+	GiftDescription string // optional
+}
+
+// ConfirmGiftHandler lets the giver confirm giving (with a description) or
+// the receiver confirm receiving, and tells the other side.
+type ConfirmGiftHandler struct {
+	Repo         ports.CompetitionRepository
+	Users        ports.UserRepository
+	Achievements *appAchievement.Evaluator
+	Notifier     ports.Notifier // optional
+}
+
+func NewConfirmGiftHandler(
+	repo ports.CompetitionRepository,
+	users ports.UserRepository,
+	achievements *appAchievement.Evaluator,
+	notifier ports.Notifier,
+) *ConfirmGiftHandler {
+	return &ConfirmGiftHandler{Repo: repo, Users: users, Achievements: achievements, Notifier: notifier}
+}
+
+func (h *ConfirmGiftHandler) Handle(cmd ConfirmGiftCommand) (*competition.GiftExchange, error) {
+	if cmd.GiftID == "" {
+		return nil, core.New(core.ValidationError, "gift id is required")
+	}
+
+	g, err := h.Repo.GetGiftExchange(cmd.GiftID)
+	if err != nil {
+		return nil, err
+	}
+
+	var first bool // only the first confirmation of each side is news to the other
+	switch cmd.UserID {
+	case g.GiverID:
+		first = !g.GiverConfirmed
+		g.GiverConfirmed = true
+	case g.ReceiverID:
+		first = !g.ReceiverConfirmed
+		g.ReceiverConfirmed = true
+	default:
+		return nil, core.New(core.AuthError, "you are not part of this gift exchange")
+	}
+	if cmd.GiftDescription != "" {
+		g.GiftDescription = cmd.GiftDescription
+	}
+
+	if err := h.Repo.UpdateGiftExchange(g); err != nil {
+		return nil, err
+	}
+
+	// giving a gift may unlock a badge (best effort)
+	if cmd.UserID == g.GiverID {
+		_, _, _ = h.Achievements.Evaluate(cmd.UserID)
+	}
+	if first && h.Notifier != nil {
+		h.Notifier.Notify(h.confirmedNotification(g, cmd.UserID))
+	}
+	return g, nil
+}
+
+// confirmedNotification tells the other side of the pairing that by confirmed.
+func (h *ConfirmGiftHandler) confirmedNotification(g *competition.GiftExchange, by string) *notification.Notification {
+	title := "Gift exchange"
+	if cmp, err := h.Repo.Get(g.CompetitionID); err == nil {
+		title += " for " + cmp.Name
+	}
+
+	if by == g.ReceiverID {
+		body := fmt.Sprintf("%s has received your gift.", displayName(h.Users, g.ReceiverID))
+		return notification.New(g.GiverID, notification.KindGiftReceived, title, body).About(g.CompetitionID)
+	}
+	body := fmt.Sprintf("%s has sent your gift.", displayName(h.Users, g.GiverID))
+	if g.GiftDescription != "" {
+		body = fmt.Sprintf("%s has sent your gift: %s.", displayName(h.Users, g.GiverID), g.GiftDescription)
+	}
+	return notification.New(g.ReceiverID, notification.KindGiftSent, title, body).About(g.CompetitionID)
+}
@@ -3,24 +3,42 @@ package competition
 import (
 	"time"
 
+	appXP "github.com/bakhtybayevn/powerbook/internal/application/xp"
 	"github.com/bakhtybayevn/powerbook/internal/core"
 	"github.com/bakhtybayevn/powerbook/internal/domain/competition"
 	"github.com/bakhtybayevn/powerbook/internal/ports"
 )
 
 type CreateCompetitionCommand struct {
+	UserID          string // becomes the owner
 	Name            string
 	StartDate       time.Time
 	EndDate         time.Time
 	PointsPerMinute int
+
+	// scoring strategy; empty ScoringType means minutes
+	ScoringType    string
+	PointsPerUnit  int
+	DailyMinuteCap int
+
+	// "individual" (default) or "team"
+	Format string
+
+	// access: "public", "unlisted" or "private"; empty is public for those
+	// who have unlocked public competitions and unlisted otherwise
+	Visibility       string
+	RequiresApproval bool
+	MaxParticipants  int
 }
 
 type CreateCompetitionHandler struct {
-	Repo ports.CompetitionRepository
+	Repo     ports.CompetitionRepository
+	UserRepo ports.UserRepository
+	Perks    *appXP.PerksReader
 }
 
-func NewCreateCompetitionHandler(repo ports.CompetitionRepository) *CreateCompetitionHandler {
-	return &CreateCompetitionHandler{Repo: repo}
+func NewCreateCompetitionHandler(repo ports.CompetitionRepository, userRepo ports.UserRepository, perks *appXP.PerksReader) *CreateCompetitionHandler {
+	return &CreateCompetitionHandler{Repo: repo, UserRepo: userRepo, Perks: perks}
 }
 
 func (h *CreateCompetitionHandler) Handle(cmd CreateCompetitionCommand) (*competition.Competition, error) {
@@ -30,16 +48,18 @@ func (h *CreateCompetitionHandler) Handle(cmd CreateCompetitionCommand) (*compet
 		return nil, core.New(core.ValidationError, "competition name is required")
 	}
 
-	if cmd.PointsPerMinute <= 0 {
-		return nil, core.New(core.ValidationError, "points_per_minute must be > 0")
-	}
-
 	if cmd.EndDate.Before(cmd.StartDate) {
 		return nil, core.New(core.ValidationError, "end_date cannot be before start_date")
 	}
 
 	rules := competition.Rules{
+		Type:            competition.ScoringType(cmd.ScoringType),
 		PointsPerMinute: cmd.PointsPerMinute,
+		PointsPerUnit:   cmd.PointsPerUnit,
+		DailyMinuteCap:  cmd.DailyMinuteCap,
+	}
+	if err := rules.Validate(); err != nil {
+		return nil, core.New(core.ValidationError, err.Error())
 	}
 
 	cmp, err := competition.NewCompetition(cmd.Name, cmd.StartDate, cmd.EndDate, rules)
@@ -47,6 +67,30 @@ func (h *CreateCompetitionHandler) Handle(cmd CreateCompetitionCommand) (*compet
 		return nil, core.New(core.ValidationError, err.Error())
 	}
 
+	if err := cmp.SetFormat(competition.Format(cmd.Format)); err != nil {
+		return nil, core.New(core.ValidationError, err.Error())
+	}
+
+	cmp.OwnerID = cmd.UserID
+	cmp.RequiresApproval = cmd.RequiresApproval
+	visibility := competition.Visibility(cmd.Visibility)
+	if visibility == "" {
+		if visibility, err = defaultVisibility(cmd.UserID, h.UserRepo, h.Perks); err != nil {
+			return nil, err
+		}
+	}
+	if err := cmp.SetVisibility(visibility); err != nil {
+		return nil, core.New(core.ValidationError, err.Error())
+	}
+	if cmp.Visibility == competition.VisibilityPublic {
+		if err := requirePublicPerk(cmd.UserID, h.UserRepo, h.Perks); err != nil {
+			return nil, err
+		}
+	}
+	if err := cmp.SetMaxParticipants(cmd.MaxParticipants); err != nil {
+		return nil, core.New(core.ValidationError, err.Error())
+	}
+
 	if err := h.Repo.Create(cmp); err != nil {
 		return nil, core.New(core.ServerError, "failed to save competition")
 	}
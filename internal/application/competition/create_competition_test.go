@@ -0,0 +1,66 @@
+package competition
+
+import (
+	"testing"
+	"time"
+
+	appXP "github.com/bakhtybayevn/powerbook/internal/application/xp"
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/competition"
+)
+
+func TestCreateCompetitionVisibility(t *testing.T) {
+	tests := []struct {
+		name       string
+		xp         int
+		admin      bool
+		visibility string
+		want       competition.Visibility
+		wantErr    core.ErrorType
+	}{
+		{name: "new reader gets unlisted by default", want: competition.VisibilityUnlisted},
+		{name: "new reader asks for public", visibility: "public", wantErr: core.AuthError},
+		{name: "new reader asks for private", visibility: "private", want: competition.VisibilityPrivate},
+		{name: "unlocked reader gets public by default", xp: 100000, want: competition.VisibilityPublic},
+		{name: "unlocked reader asks for unlisted", xp: 100000, visibility: "unlisted", want: competition.VisibilityUnlisted},
+		{name: "admin gets public by default", admin: true, want: competition.VisibilityPublic},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newCloseFixture(t, competition.FormatIndividual)
+			u, err := f.repos.Users.Get(f.user(t, "creator"))
+			if err != nil {
+				t.Fatalf("get user: %v", err)
+			}
+			u.XP = tt.xp
+			u.IsAdmin = tt.admin
+			if err := f.repos.Users.Save(u); err != nil {
+				t.Fatalf("save user: %v", err)
+			}
+
+			start := time.Now().UTC().AddDate(0, 0, 1)
+			h := NewCreateCompetitionHandler(f.repos.Competitions, f.repos.Users, appXP.NewPerksReader(f.repos.XPPolicies, f.repos.LevelUps))
+			cmp, err := h.Handle(CreateCompetitionCommand{
+				UserID:          u.ID,
+				Name:            "Weekend read",
+				StartDate:       start,
+				EndDate:         start.AddDate(0, 0, 2),
+				PointsPerMinute: 1,
+				Visibility:      tt.visibility,
+			})
+			if tt.wantErr != "" {
+				if !core.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %s", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("create: %v", err)
+			}
+			if cmp.Visibility != tt.want {
+				t.Errorf("visibility = %s, want %s", cmp.Visibility, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package competition
+
+import (
+	"context"
+
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/competition"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+type CreateTeamCommand struct {
+	UserID        string
+	CompetitionID string
+	Name          string
+	InviteCode    string // required for private competitions
+}
+
+type CreateTeamHandler struct {
+	UoW         ports.UnitOfWork // the seat check and the insert see the same count
+	Leaderboard ports.LeaderboardPort
+}
+
+func NewCreateTeamHandler(uow ports.UnitOfWork, leaderboard ports.LeaderboardPort) *CreateTeamHandler {
+	return &CreateTeamHandler{UoW: uow, Leaderboard: leaderboard}
+}
+
+// Handle creates a team inside a team competition. The creator becomes the
+// team captain and joins the competition as its first member.
+func (h *CreateTeamHandler) Handle(cmd CreateTeamCommand) (*competition.Team, error) {
+	if cmd.CompetitionID == "" {
+		return nil, core.New(core.ValidationError, "competition id is required")
+	}
+	if cmd.UserID == "" {
+		return nil, core.New(core.ValidationError, "user id is required")
+	}
+
+	var team *competition.Team
+	err := h.UoW.Do(func(tx ports.Repositories) error {
+		cmp, err := tx.Competitions.GetForUpdate(cmd.CompetitionID)
+		if err != nil {
+			return core.New(core.NotFoundError, "competition not found")
+		}
+
+		if !cmp.IsTeamCompetition() {
+			return core.New(core.ValidationError, "competition is not a team competition")
+		}
+		if err := checkAdmission(cmp, cmd.UserID, cmd.InviteCode); err != nil {
+			return err
+		}
+		if err := checkNotKicked(tx.Competitions, cmp.ID, cmd.UserID); err != nil {
+			return err
+		}
+		// captains join directly, which would bypass the owner's approval
+		if cmp.NeedsApproval(cmd.UserID) {
+			return core.New(core.ValidationError, "competition requires approval; request to join an existing team instead")
+		}
+		if cmp.TeamNameTaken(cmd.Name) {
+			return core.New(core.ValidationError, "team name already taken in this competition")
+		}
+
+		team, err = competition.NewTeam(cmp.ID, cmd.Name, cmd.UserID)
+		if err != nil {
+			return core.New(core.ValidationError, err.Error())
+		}
+
+		if err := tx.Competitions.SaveTeam(team); err != nil {
+			return core.New(core.ServerError, "failed to save team")
+		}
+
+		p := competition.NewParticipant(cmd.UserID)
+		p.TeamID = team.ID
+		if err := tx.Competitions.SaveParticipant(cmp.ID, p); err != nil {
+			return core.New(core.ServerError, "failed to add participant")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// make the team visible on the team board right away (best effort)
+	_ = h.Leaderboard.SetTeamScore(context.Background(), team.CompetitionID, team.ID, 0)
+
+	return team, nil
+}
@@ -9,45 +9,119 @@ import (
 type JoinCompetitionCommand struct {
 	UserID        string
 	CompetitionID string
+	TeamID        string // required for team competitions
+	InviteCode    string // required for private competitions
 }
 
+// JoinCompetitionResult tells whether the user is in, or waiting for approval.
+type JoinCompetitionResult struct {
+	Status string // "joined" or "pending"
+}
+
+const (
+	JoinStatusJoined  = "joined"
+	JoinStatusPending = "pending"
+)
+
 type JoinCompetitionHandler struct {
-	Repo ports.CompetitionRepository
+	UoW ports.UnitOfWork // the seat check and the insert see the same count
 }
 
-func NewJoinCompetitionHandler(repo ports.CompetitionRepository) *JoinCompetitionHandler {
-	return &JoinCompetitionHandler{Repo: repo}
+func NewJoinCompetitionHandler(uow ports.UnitOfWork) *JoinCompetitionHandler {
+	return &JoinCompetitionHandler{UoW: uow}
 }
 
-func (h *JoinCompetitionHandler) Handle(cmd JoinCompetitionCommand) error {
+func (h *JoinCompetitionHandler) Handle(cmd JoinCompetitionCommand) (*JoinCompetitionResult, error) {
 	if cmd.CompetitionID == "" {
-		return core.New(core.ValidationError, "competition id is required")
+		return nil, core.New(core.ValidationError, "competition id is required")
 	}
 	if cmd.UserID == "" {
-		return core.New(core.ValidationError, "user id is required")
+		return nil, core.New(core.ValidationError, "user id is required")
 	}
 
-	cmp, err := h.Repo.Get(cmd.CompetitionID)
+	var result *JoinCompetitionResult
+	err := h.UoW.Do(func(tx ports.Repositories) error {
+		var err error
+		result, err = join(tx.Competitions, cmd)
+		return err
+	})
 	if err != nil {
-		return core.New(core.NotFoundError, "competition not found")
+		return nil, err
 	}
+	return result, nil
+}
 
-	if cmp.Status == competition.StatusClosed {
-		return core.New(core.ValidationError, "competition is closed")
+func join(repo ports.CompetitionRepository, cmd JoinCompetitionCommand) (*JoinCompetitionResult, error) {
+	cmp, err := repo.GetForUpdate(cmd.CompetitionID)
+	if err != nil {
+		return nil, core.New(core.NotFoundError, "competition not found")
 	}
 
-	// Prevent duplicate join
-	if _, exists := cmp.Participants[cmd.UserID]; exists {
-		return core.New(core.ValidationError, "user already joined this competition")
+	if err := checkAdmission(cmp, cmd.UserID, cmd.InviteCode); err != nil {
+		return nil, err
+	}
+	if err := checkNotKicked(repo, cmp.ID, cmd.UserID); err != nil {
+		return nil, err
+	}
+
+	if cmp.IsTeamCompetition() {
+		if cmd.TeamID == "" {
+			return nil, core.New(core.ValidationError, "team id is required for team competitions")
+		}
+		if _, ok := cmp.Teams[cmd.TeamID]; !ok {
+			return nil, core.New(core.NotFoundError, "team not found")
+		}
+	} else if cmd.TeamID != "" {
+		return nil, core.New(core.ValidationError, "competition is not a team competition")
+	}
+
+	if cmp.NeedsApproval(cmd.UserID) {
+		if prev, err := repo.GetJoinRequest(cmp.ID, cmd.UserID); err == nil {
+			switch prev.Status {
+			case competition.JoinPending:
+				return nil, core.New(core.ValidationError, "join request already pending")
+			case competition.JoinRejected:
+				return nil, core.New(core.ValidationError, "join request was rejected")
+			}
+		}
+
+		if err := repo.SaveJoinRequest(competition.NewJoinRequest(cmp.ID, cmd.UserID, cmd.TeamID)); err != nil {
+			return nil, err
+		}
+		return &JoinCompetitionResult{Status: JoinStatusPending}, nil
 	}
 
 	// Add participant
 	p := competition.NewParticipant(cmd.UserID)
-    cmp.Participants[cmd.UserID] = p
+	p.TeamID = cmd.TeamID
+	cmp.Participants[cmd.UserID] = p
+
+	if err := repo.SaveParticipant(cmd.CompetitionID, p); err != nil {
+		return nil, core.New(core.ServerError, "failed to add participant")
+	}
 
-	if err := h.Repo.SaveParticipant(cmd.CompetitionID, p); err != nil {
-        return core.New(core.ServerError, "failed to add participant")
-    }
+	return &JoinCompetitionResult{Status: JoinStatusJoined}, nil
+}
 
+// checkAdmission applies the entry rules shared by every way into a
+// competition: it must be open, the user not already in, the invite code
+// valid for private competitions and a seat left.
+func checkAdmission(cmp *competition.Competition, userID, inviteCode string) error {
+	if !cmp.IsOpen() {
+		return core.New(core.ValidationError, "competition is "+string(cmp.Status))
+	}
+
+	// Prevent duplicate join
+	if _, exists := cmp.Participants[userID]; exists {
+		return core.New(core.ValidationError, "user already joined this competition")
+	}
+
+	if err := cmp.CheckInvite(userID, inviteCode); err != nil {
+		return core.New(core.ValidationError, err.Error())
+	}
+
+	if cmp.IsFull() {
+		return core.New(core.ValidationError, "competition is full")
+	}
 	return nil
 }
@@ -0,0 +1,174 @@
+package competition
+
+import (
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/competition"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// -------------------------------------
+// LIST PENDING JOIN REQUESTS
+// -------------------------------------
+
+type ListJoinRequestsCommand struct {
+	ActorID       string
+	CompetitionID string
+}
+
+type ListJoinRequestsHandler struct {
+	Repo     ports.CompetitionRepository
+	UserRepo ports.UserRepository
+}
+
+func NewListJoinRequestsHandler(repo ports.CompetitionRepository, userRepo ports.UserRepository) *ListJoinRequestsHandler {
+	return &ListJoinRequestsHandler{Repo: repo, UserRepo: userRepo}
+}
+
+func (h *ListJoinRequestsHandler) Handle(cmd ListJoinRequestsCommand) ([]*competition.JoinRequest, error) {
+	cmp, err := h.Repo.Get(cmd.CompetitionID)
+	if err != nil {
+		return nil, core.New(core.NotFoundError, "competition not found")
+	}
+	if err := requireOrganizer(cmp, cmd.ActorID, h.UserRepo); err != nil {
+		return nil, err
+	}
+
+	return h.Repo.ListJoinRequests(cmp.ID, competition.JoinPending)
+}
+
+// -------------------------------------
+// APPROVE / REJECT JOIN REQUEST
+// -------------------------------------
+
+type DecideJoinRequestCommand struct {
+	ActorID       string
+	CompetitionID string
+	UserID        string
+	Approve       bool
+}
+
+type DecideJoinRequestHandler struct {
+	UoW      ports.UnitOfWork // the seat check and the insert see the same count
+	UserRepo ports.UserRepository
+}
+
+func NewDecideJoinRequestHandler(uow ports.UnitOfWork, userRepo ports.UserRepository) *DecideJoinRequestHandler {
+	return &DecideJoinRequestHandler{UoW: uow, UserRepo: userRepo}
+}
+
+// Handle approves or rejects a pending request. Approval re-checks the
+// seat limit and team, since both may have changed since the request.
+func (h *DecideJoinRequestHandler) Handle(cmd DecideJoinRequestCommand) (*competition.JoinRequest, error) {
+	var jr *competition.JoinRequest
+	err := h.UoW.Do(func(tx ports.Repositories) error {
+		var err error
+		jr, err = h.decide(tx.Competitions, cmd)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return jr, nil
+}
+
+func (h *DecideJoinRequestHandler) decide(repo ports.CompetitionRepository, cmd DecideJoinRequestCommand) (*competition.JoinRequest, error) {
+	cmp, err := repo.GetForUpdate(cmd.CompetitionID)
+	if err != nil {
+		return nil, core.New(core.NotFoundError, "competition not found")
+	}
+	if err := requireOrganizer(cmp, cmd.ActorID, h.UserRepo); err != nil {
+		return nil, err
+	}
+
+	jr, err := repo.GetJoinRequest(cmp.ID, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if jr.Status != competition.JoinPending {
+		return nil, core.New(core.ValidationError, "join request already decided")
+	}
+
+	if cmd.Approve {
+		if !cmp.IsOpen() {
+			return nil, core.New(core.ValidationError, "competition is "+string(cmp.Status))
+		}
+		if cmp.IsFull() {
+			return nil, core.New(core.ValidationError, "competition is full")
+		}
+		if err := checkNotKicked(repo, cmp.ID, jr.UserID); err != nil {
+			return nil, err
+		}
+		if jr.TeamID != "" {
+			if _, ok := cmp.Teams[jr.TeamID]; !ok {
+				return nil, core.New(core.ValidationError, "requested team no longer exists")
+			}
+		}
+	}
+
+	if err := jr.Decide(cmd.Approve); err != nil {
+		return nil, core.New(core.ValidationError, err.Error())
+	}
+
+	if cmd.Approve {
+		if _, exists := cmp.Participants[jr.UserID]; !exists {
+			p := competition.NewParticipant(jr.UserID)
+			p.TeamID = jr.TeamID
+			if err := repo.SaveParticipant(cmp.ID, p); err != nil {
+				return nil, core.New(core.ServerError, "failed to add participant")
+			}
+		}
+	}
+
+	if err := repo.SaveJoinRequest(jr); err != nil {
+		return nil, err
+	}
+	return jr, nil
+}
+
+// -------------------------------------
+// REGENERATE INVITE CODE
+// -------------------------------------
+
+type RegenerateInviteCodeCommand struct {
+	ActorID       string
+	CompetitionID string
+}
+
+type RegenerateInviteCodeHandler struct {
+	UoW      ports.UnitOfWork
+	UserRepo ports.UserRepository
+}
+
+func NewRegenerateInviteCodeHandler(uow ports.UnitOfWork, userRepo ports.UserRepository) *RegenerateInviteCodeHandler {
+	return &RegenerateInviteCodeHandler{UoW: uow, UserRepo: userRepo}
+}
+
+// Handle issues a fresh invite code; links with the old one stop working.
+func (h *RegenerateInviteCodeHandler) Handle(cmd RegenerateInviteCodeCommand) (string, error) {
+	var code string
+	err := h.UoW.Do(func(tx ports.Repositories) error {
+		cmp, err := tx.Competitions.GetForUpdate(cmd.CompetitionID)
+		if err != nil {
+			return core.New(core.NotFoundError, "competition not found")
+		}
+		if err := requireOrganizer(cmp, cmd.ActorID, h.UserRepo); err != nil {
+			return err
+		}
+		if !cmp.IsOpen() {
+			return core.New(core.ValidationError, "competition is "+string(cmp.Status))
+		}
+
+		if err := cmp.RegenerateInviteCode(); err != nil {
+			return core.New(core.ServerError, err.Error())
+		}
+		if err := tx.Competitions.Save(cmp); err != nil {
+			return err
+		}
+		code = cmp.InviteCode
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return code, nil
+}
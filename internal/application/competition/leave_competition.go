@@ -0,0 +1,158 @@
+package competition
+
+import (
+	"context"
+
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/competition"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// -------------------------------------
+// LEAVE
+// -------------------------------------
+
+type LeaveCompetitionCommand struct {
+	UserID        string
+	CompetitionID string
+}
+
+type LeaveCompetitionHandler struct {
+	UoW         ports.UnitOfWork
+	Leaderboard ports.LeaderboardPort
+}
+
+func NewLeaveCompetitionHandler(uow ports.UnitOfWork, leaderboard ports.LeaderboardPort) *LeaveCompetitionHandler {
+	return &LeaveCompetitionHandler{UoW: uow, Leaderboard: leaderboard}
+}
+
+func (h *LeaveCompetitionHandler) Handle(cmd LeaveCompetitionCommand) error {
+	if cmd.CompetitionID == "" {
+		return core.New(core.ValidationError, "competition id is required")
+	}
+	if cmd.UserID == "" {
+		return core.New(core.ValidationError, "user id is required")
+	}
+
+	var (
+		cmp *competition.Competition
+		p   *competition.Participant
+	)
+	err := h.UoW.Do(func(tx ports.Repositories) error {
+		var err error
+		cmp, err = tx.Competitions.Get(cmd.CompetitionID)
+		if err != nil {
+			return core.New(core.NotFoundError, "competition not found")
+		}
+		p, err = removeParticipant(tx.Competitions, cmp, cmd.UserID)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	dropFromBoards(h.Leaderboard, cmp, p)
+	return nil
+}
+
+// -------------------------------------
+// KICK
+// -------------------------------------
+
+type KickParticipantCommand struct {
+	ActorID       string
+	CompetitionID string
+	UserID        string
+	Reason        string
+}
+
+type KickParticipantHandler struct {
+	UoW         ports.UnitOfWork // the removal and the kick record commit together
+	UserRepo    ports.UserRepository
+	Leaderboard ports.LeaderboardPort
+}
+
+func NewKickParticipantHandler(uow ports.UnitOfWork, userRepo ports.UserRepository, leaderboard ports.LeaderboardPort) *KickParticipantHandler {
+	return &KickParticipantHandler{UoW: uow, UserRepo: userRepo, Leaderboard: leaderboard}
+}
+
+// Handle removes a participant and blocks them from joining again.
+func (h *KickParticipantHandler) Handle(cmd KickParticipantCommand) error {
+	if cmd.CompetitionID == "" {
+		return core.New(core.ValidationError, "competition id is required")
+	}
+
+	var (
+		cmp *competition.Competition
+		p   *competition.Participant
+	)
+	err := h.UoW.Do(func(tx ports.Repositories) error {
+		var err error
+		cmp, err = tx.Competitions.Get(cmd.CompetitionID)
+		if err != nil {
+			return core.New(core.NotFoundError, "competition not found")
+		}
+		if err := requireOrganizer(cmp, cmd.ActorID, h.UserRepo); err != nil {
+			return err
+		}
+		if cmd.UserID == cmd.ActorID {
+			return core.New(core.ValidationError, "use leave to exit a competition yourself")
+		}
+		if cmp.IsOrganizer(cmd.UserID) {
+			return core.New(core.ValidationError, "organizers must be removed as organizer before being kicked")
+		}
+
+		p, err = removeParticipant(tx.Competitions, cmp, cmd.UserID)
+		if err != nil {
+			return err
+		}
+		return tx.Competitions.RecordKick(cmp.ID, cmd.UserID, cmd.ActorID, cmd.Reason)
+	})
+	if err != nil {
+		return err
+	}
+
+	dropFromBoards(h.Leaderboard, cmp, p)
+	return nil
+}
+
+// removeParticipant deletes the participant row and hands on the captaincy
+// of a team the user led.
+func removeParticipant(repo ports.CompetitionRepository, cmp *competition.Competition, userID string) (*competition.Participant, error) {
+	p, captained, err := cmp.RemoveParticipant(userID)
+	if err != nil {
+		return nil, core.New(core.ValidationError, err.Error())
+	}
+
+	if err := repo.DeleteParticipant(cmp.ID, userID); err != nil {
+		return nil, err
+	}
+	if captained != nil {
+		if err := repo.SaveTeam(captained); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// dropFromBoards takes a removed participant off the leaderboards once the
+// removal has committed. Redis cleanup is best effort; a rebuild fixes any leftovers.
+func dropFromBoards(lb ports.LeaderboardPort, cmp *competition.Competition, p *competition.Participant) {
+	ctx := context.Background()
+	_ = lb.RemoveMember(ctx, cmp.ID, p.UserID)
+	if p.TeamID != "" {
+		_ = lb.SetTeamScore(ctx, cmp.ID, p.TeamID, float64(cmp.TeamPoints(p.TeamID)))
+	}
+}
+
+// checkNotKicked blocks users an organizer removed from coming back.
+func checkNotKicked(repo ports.CompetitionRepository, competitionID, userID string) error {
+	kicked, err := repo.IsKicked(competitionID, userID)
+	if err != nil {
+		return err
+	}
+	if kicked {
+		return core.New(core.ValidationError, "you were removed from this competition by an organizer")
+	}
+	return nil
+}
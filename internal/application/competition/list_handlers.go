@@ -16,12 +16,26 @@ func NewListAllCompetitionsHandler(comp ports.CompetitionRepository, users ports
 	return &ListAllCompetitionsHandler{CompRepo: comp, UserRepo: users}
 }
 
-func (h *ListAllCompetitionsHandler) Handle() ([]*competition.Competition, map[string]*user.User, error) {
-	comps, err := h.CompRepo.GetAll()
+type ListAllCommand struct {
+	ViewerID string // empty for anonymous callers
+}
+
+// Handle lists public competitions, plus unlisted and private ones the
+// viewer owns or takes part in.
+func (h *ListAllCompetitionsHandler) Handle(cmd ListAllCommand) ([]*competition.Competition, map[string]*user.User, error) {
+	all, err := h.CompRepo.GetAll()
 	if err != nil {
 		return nil, nil, err
 	}
 
+	comps := make([]*competition.Competition, 0, len(all))
+	for _, c := range all {
+		_, joined := c.Participants[cmd.ViewerID]
+		if c.IsListed() || c.IsOrganizer(cmd.ViewerID) || joined {
+			comps = append(comps, c)
+		}
+	}
+
 	allUsers := map[string]*user.User{}
 	for _, c := range comps {
 		for uid := range c.Participants {
@@ -0,0 +1,88 @@
+package competition
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bakhtybayevn/powerbook/internal/domain/competition"
+	"github.com/bakhtybayevn/powerbook/internal/domain/notification"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// closeNotifications tells every participant where they finished and both
+// sides of each gift pairing who they are paired with.
+func closeNotifications(users ports.UserRepository, cmp *competition.Competition, winners []Winner, gifts []*competition.GiftExchange) []*notification.Notification {
+	title := cmp.Name + " has ended"
+
+	var out []*notification.Notification
+	for _, w := range winners {
+		body := fmt.Sprintf("You placed #%d of %d with %d pts and earned %d XP.", w.Rank, len(winners), w.Points, w.XPEarned)
+		if w.TeamID != "" {
+			body = fmt.Sprintf("Your team %s placed #%d. You scored %d pts and earned %d XP.", w.TeamName, w.TeamRank, w.Points, w.XPEarned)
+		}
+		out = append(out, notification.New(w.UserID, notification.KindCompetitionResult, title, body).
+			About(cmp.ID).
+			Once("competition_result:"+cmp.ID))
+	}
+
+	giftTitle := "Gift exchange for " + cmp.Name
+	for _, g := range gifts {
+		out = append(out,
+			notification.New(g.GiverID, notification.KindGiftPairing, giftTitle,
+				fmt.Sprintf("You give a gift to %s.", displayName(users, g.ReceiverID))).
+				About(cmp.ID).
+				Once("gift_pairing:"+g.ID),
+			notification.New(g.ReceiverID, notification.KindGiftPairing, giftTitle,
+				fmt.Sprintf("%s will give you a gift.", displayName(users, g.GiverID))).
+				About(cmp.ID).
+				Once("gift_pairing:"+g.ID),
+		)
+	}
+	return out
+}
+
+func displayName(users ports.UserRepository, userID string) string {
+	if u, err := users.Get(userID); err == nil {
+		return u.DisplayName
+	}
+	return "another reader"
+}
+
+// startWindow bounds how late a start is still announced, so the first run
+// after a deploy doesn't announce competitions that began long ago.
+const startWindow = 24 * time.Hour
+
+// NotifyStartedHandler tells participants that a competition has begun.
+// The scheduler runs it every tick; each participant hears about a start
+// once, however many ticks see it.
+type NotifyStartedHandler struct {
+	Competitions ports.CompetitionRepository
+	Notifier     ports.Notifier
+}
+
+func NewNotifyStartedHandler(competitions ports.CompetitionRepository, notifier ports.Notifier) *NotifyStartedHandler {
+	return &NotifyStartedHandler{Competitions: competitions, Notifier: notifier}
+}
+
+func (h *NotifyStartedHandler) Handle(now time.Time) error {
+	comps, err := h.Competitions.FindActive(now)
+	if err != nil {
+		return err
+	}
+
+	for _, cmp := range comps {
+		if now.Sub(cmp.StartDate) > startWindow {
+			continue
+		}
+		var ns []*notification.Notification
+		for userID := range cmp.Participants {
+			ns = append(ns, notification.New(userID, notification.KindCompetitionStarted,
+				cmp.Name+" has started",
+				fmt.Sprintf("Reading now counts until %s.", cmp.EndDate.Format("Jan 2, 15:04 MST"))).
+				About(cmp.ID).
+				Once("competition_started:"+cmp.ID))
+		}
+		h.Notifier.Notify(ns...)
+	}
+	return nil
+}
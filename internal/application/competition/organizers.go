@@ -0,0 +1,108 @@
+package competition
+
+import (
+	"context"
+
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// -------------------------------------
+// ADD / REMOVE CO-ORGANIZER
+// -------------------------------------
+
+type ManageOrganizerCommand struct {
+	ActorID       string
+	CompetitionID string
+	UserID        string
+}
+
+type AddOrganizerHandler struct {
+	Repo     ports.CompetitionRepository
+	UserRepo ports.UserRepository
+}
+
+func NewAddOrganizerHandler(repo ports.CompetitionRepository, userRepo ports.UserRepository) *AddOrganizerHandler {
+	return &AddOrganizerHandler{Repo: repo, UserRepo: userRepo}
+}
+
+func (h *AddOrganizerHandler) Handle(cmd ManageOrganizerCommand) error {
+	cmp, err := h.Repo.Get(cmd.CompetitionID)
+	if err != nil {
+		return core.New(core.NotFoundError, "competition not found")
+	}
+	if err := requireOwner(cmp, cmd.ActorID, h.UserRepo); err != nil {
+		return err
+	}
+	if _, err := h.UserRepo.Get(cmd.UserID); err != nil {
+		return core.New(core.NotFoundError, "user not found")
+	}
+
+	if err := cmp.AddOrganizer(cmd.UserID); err != nil {
+		return core.New(core.ValidationError, err.Error())
+	}
+	return h.Repo.AddOrganizer(cmp.ID, cmd.UserID)
+}
+
+type RemoveOrganizerHandler struct {
+	Repo     ports.CompetitionRepository
+	UserRepo ports.UserRepository
+}
+
+func NewRemoveOrganizerHandler(repo ports.CompetitionRepository, userRepo ports.UserRepository) *RemoveOrganizerHandler {
+	return &RemoveOrganizerHandler{Repo: repo, UserRepo: userRepo}
+}
+
+func (h *RemoveOrganizerHandler) Handle(cmd ManageOrganizerCommand) error {
+	cmp, err := h.Repo.Get(cmd.CompetitionID)
+	if err != nil {
+		return core.New(core.NotFoundError, "competition not found")
+	}
+	if err := requireOwner(cmp, cmd.ActorID, h.UserRepo); err != nil {
+		return err
+	}
+
+	if err := cmp.RemoveOrganizer(cmd.UserID); err != nil {
+		return core.New(core.ValidationError, err.Error())
+	}
+	return h.Repo.RemoveOrganizer(cmp.ID, cmd.UserID)
+}
+
+// -------------------------------------
+// DELETE COMPETITION
+// -------------------------------------
+
+type DeleteCompetitionCommand struct {
+	ActorID       string
+	CompetitionID string
+}
+
+type DeleteCompetitionHandler struct {
+	Repo        ports.CompetitionRepository
+	UserRepo    ports.UserRepository
+	Leaderboard ports.LeaderboardPort
+}
+
+func NewDeleteCompetitionHandler(repo ports.CompetitionRepository, userRepo ports.UserRepository, leaderboard ports.LeaderboardPort) *DeleteCompetitionHandler {
+	return &DeleteCompetitionHandler{Repo: repo, UserRepo: userRepo, Leaderboard: leaderboard}
+}
+
+// Handle removes a competition with its participants, teams and gifts.
+// Already awarded XP stays with the users.
+func (h *DeleteCompetitionHandler) Handle(cmd DeleteCompetitionCommand) error {
+	cmp, err := h.Repo.Get(cmd.CompetitionID)
+	if err != nil {
+		return core.New(core.NotFoundError, "competition not found")
+	}
+	if err := requireOrganizer(cmp, cmd.ActorID, h.UserRepo); err != nil {
+		return err
+	}
+
+	if err := h.Repo.Delete(cmp.ID); err != nil {
+		return err
+	}
+
+	// stale boards are harmless, so cleanup is best effort
+	_ = h.Leaderboard.Clear(context.Background(), cmp.ID)
+	return nil
+}
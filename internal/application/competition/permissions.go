@@ -0,0 +1,82 @@
+package competition
+
+import (
+	"fmt"
+
+	appXP "github.com/bakhtybayevn/powerbook/internal/application/xp"
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/competition"
+	"github.com/bakhtybayevn/powerbook/internal/domain/xp"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// requireOrganizer lets the competition's owner, its co-organizers and
+// platform admins through.
+func requireOrganizer(cmp *competition.Competition, actorID string, users ports.UserRepository) error {
+	if actorID == "" {
+		return core.New(core.AuthError, "unauthorized")
+	}
+	if cmp.IsOrganizer(actorID) || isAdmin(actorID, users) {
+		return nil
+	}
+	return core.New(core.AuthError, "only competition organizers can do this")
+}
+
+// requireOwner is stricter: managing the organizer list is left to the
+// owner (and admins).
+func requireOwner(cmp *competition.Competition, actorID string, users ports.UserRepository) error {
+	if actorID == "" {
+		return core.New(core.AuthError, "unauthorized")
+	}
+	if cmp.IsOwner(actorID) || isAdmin(actorID, users) {
+		return nil
+	}
+	return core.New(core.AuthError, "only the competition owner can do this")
+}
+
+// requirePublicPerk checks that the user may run a public competition, a
+// level reward; admins always may.
+func requirePublicPerk(userID string, users ports.UserRepository, perks *appXP.PerksReader) error {
+	ok, err := mayRunPublic(userID, users, perks)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return core.New(core.AuthError, fmt.Sprintf(
+			"public competitions unlock at level %d; make it unlisted or private instead", xp.PublicCompetitionsLevel))
+	}
+	return nil
+}
+
+// defaultVisibility is what a competition gets when the creator picks none:
+// public for those who may run one, unlisted for everyone else.
+func defaultVisibility(userID string, users ports.UserRepository, perks *appXP.PerksReader) (competition.Visibility, error) {
+	ok, err := mayRunPublic(userID, users, perks)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return competition.VisibilityUnlisted, nil
+	}
+	return competition.VisibilityPublic, nil
+}
+
+func mayRunPublic(userID string, users ports.UserRepository, perks *appXP.PerksReader) (bool, error) {
+	u, err := users.Get(userID)
+	if err != nil {
+		return false, core.New(core.NotFoundError, "user not found")
+	}
+	if u.IsAdmin {
+		return true, nil
+	}
+	p, err := perks.For(u)
+	if err != nil {
+		return false, err
+	}
+	return p.PublicCompetitions, nil
+}
+
+func isAdmin(userID string, users ports.UserRepository) bool {
+	u, err := users.Get(userID)
+	return err == nil && u.IsAdmin
+}
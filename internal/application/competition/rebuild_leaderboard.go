@@ -0,0 +1,143 @@
+package competition
+
+import (
+	"context"
+	"log"
+
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/competition"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// LeaderboardReconciler keeps the Redis boards in line with participant
+// points in Postgres, which stay the source of truth. Score pushes from
+// reading logs are best effort, so an outage or flush leaves drift behind.
+type LeaderboardReconciler struct {
+	Repo        ports.CompetitionRepository
+	Leaderboard ports.LeaderboardPort
+}
+
+func NewLeaderboardReconciler(repo ports.CompetitionRepository, leaderboard ports.LeaderboardPort) *LeaderboardReconciler {
+	return &LeaderboardReconciler{Repo: repo, Leaderboard: leaderboard}
+}
+
+type RebuildResult struct {
+	CompetitionID string `json:"competition_id"`
+	Participants  int    `json:"participants"`
+	Teams         int    `json:"teams"`
+}
+
+// Rebuild replaces a competition's boards with the stored participant points.
+func (r *LeaderboardReconciler) Rebuild(competitionID string) (*RebuildResult, error) {
+	cmp, err := r.Repo.Get(competitionID)
+	if err != nil {
+		return nil, core.New(core.NotFoundError, "competition not found")
+	}
+
+	entries, teams := expectedBoards(cmp)
+	if err := r.Leaderboard.Rebuild(context.Background(), cmp.ID, entries, teams); err != nil {
+		return nil, core.New(core.ServerError, "failed to rebuild leaderboard")
+	}
+
+	return &RebuildResult{CompetitionID: cmp.ID, Participants: len(entries), Teams: len(teams)}, nil
+}
+
+// Drifted reports whether the individual or team board disagrees with Postgres.
+func (r *LeaderboardReconciler) Drifted(cmp *competition.Competition) (bool, error) {
+	ctx := context.Background()
+	entries, teams := expectedBoards(cmp)
+
+	live, err := r.Leaderboard.GetTop(ctx, cmp.ID, 0)
+	if err != nil {
+		return false, err
+	}
+	want := make(map[string]float64, len(entries))
+	for _, e := range entries {
+		want[e.UserID] = e.Score
+	}
+	got := make(map[string]float64, len(live))
+	for _, e := range live {
+		got[e.UserID] = e.Score
+	}
+	if scoresDiffer(want, got) {
+		return true, nil
+	}
+
+	if !cmp.IsTeamCompetition() {
+		return false, nil
+	}
+	liveTeams, err := r.Leaderboard.GetTopTeams(ctx, cmp.ID, 0)
+	if err != nil {
+		return false, err
+	}
+	wantTeams := make(map[string]float64, len(teams))
+	for _, t := range teams {
+		wantTeams[t.TeamID] = t.Score
+	}
+	gotTeams := make(map[string]float64, len(liveTeams))
+	for _, t := range liveTeams {
+		gotTeams[t.TeamID] = t.Score
+	}
+	return scoresDiffer(wantTeams, gotTeams), nil
+}
+
+// scoresDiffer compares a board with the expected scores. Members without
+// points may be missing from the board: they only appear on their first log.
+func scoresDiffer(want, got map[string]float64) bool {
+	for id, score := range got {
+		if w, ok := want[id]; !ok || w != score {
+			return true
+		}
+	}
+	for id, score := range want {
+		if _, ok := got[id]; !ok && score != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckOpen looks for drift on every open competition and rebuilds the
+// boards that are off. It returns how many were rebuilt.
+func (r *LeaderboardReconciler) CheckOpen() (int, error) {
+	comps, err := r.Repo.GetAll()
+	if err != nil {
+		return 0, err
+	}
+
+	rebuilt := 0
+	for _, cmp := range comps {
+		if !cmp.IsOpen() {
+			continue
+		}
+		drifted, err := r.Drifted(cmp)
+		if err != nil {
+			return rebuilt, err
+		}
+		if !drifted {
+			continue
+		}
+		log.Printf("[Leaderboard] drift detected on %s (%s), rebuilding", cmp.ID, cmp.Name)
+		entries, teams := expectedBoards(cmp)
+		if err := r.Leaderboard.Rebuild(context.Background(), cmp.ID, entries, teams); err != nil {
+			return rebuilt, err
+		}
+		rebuilt++
+	}
+	return rebuilt, nil
+}
+
+func expectedBoards(cmp *competition.Competition) ([]ports.LeaderboardEntry, []ports.TeamLeaderboardEntry) {
+	entries := make([]ports.LeaderboardEntry, 0, len(cmp.Participants))
+	for _, p := range cmp.Participants {
+		entries = append(entries, ports.LeaderboardEntry{UserID: p.UserID, Score: float64(p.Points)})
+	}
+
+	var teams []ports.TeamLeaderboardEntry
+	if cmp.IsTeamCompetition() {
+		for _, t := range cmp.Teams {
+			teams = append(teams, ports.TeamLeaderboardEntry{TeamID: t.ID, Score: float64(cmp.TeamPoints(t.ID))})
+		}
+	}
+	return entries, teams
+}
@@ -4,13 +4,19 @@ import (
 	"time"
 
 	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/competition"
 	"github.com/bakhtybayevn/powerbook/internal/ports"
 )
 
 type RecordPointsCommand struct {
-	UserID    string
-	Minutes   int
-	Timestamp time.Time
+	UserID       string
+	Minutes      int
+	PagesRead    int
+	FinishedBook bool
+	Timestamp    time.Time
+	Location     *time.Location // user's timezone for day bucketing; nil means UTC
+	DayMinutes   int            // minutes already logged that local day
+	ReadDays     []time.Time    // local days already read, for streaks
 }
 
 type RecordPointsHandler struct {
@@ -36,7 +42,12 @@ func (h *RecordPointsHandler) Handle(cmd RecordPointsCommand) error {
 			continue // user is not part of this competition
 		}
 
-		participant.AddReading(cmd.Minutes, cmd.Timestamp, cmp.Rules)
+		participant.AddReading(competition.ReadingSession{
+			Minutes:      cmd.Minutes,
+			PagesRead:    cmd.PagesRead,
+			FinishedBook: cmd.FinishedBook,
+			Timestamp:    cmd.Timestamp,
+		}, cmd.DayMinutes, cmd.ReadDays, cmp.Rules, cmd.Location)
 
 		if err := h.Repo.Save(cmp); err != nil {
 			return core.New(core.ServerError, "failed to update competition")
@@ -0,0 +1,93 @@
+package competition
+
+import (
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/competition"
+	"github.com/bakhtybayevn/powerbook/internal/domain/xp"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+type CompetitionResultsQuery struct {
+	CompetitionID string
+	ViewerID      string // empty for anonymous callers
+}
+
+type CompetitionResultsHandler struct {
+	Repo ports.CompetitionRepository
+}
+
+func NewCompetitionResultsHandler(repo ports.CompetitionRepository) *CompetitionResultsHandler {
+	return &CompetitionResultsHandler{Repo: repo}
+}
+
+// Handle returns the final standings stored when the competition closed.
+func (h *CompetitionResultsHandler) Handle(q CompetitionResultsQuery) (*competition.Competition, []competition.Result, error) {
+	cmp, err := h.Repo.Get(q.CompetitionID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !cmp.VisibleTo(q.ViewerID) {
+		return nil, nil, core.New(core.NotFoundError, "competition not found")
+	}
+	if cmp.Status != competition.StatusClosed {
+		return nil, nil, core.New(core.ValidationError, "competition has no results until it is closed")
+	}
+
+	results, err := storedResults(h.Repo, cmp)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cmp, results, nil
+}
+
+// storedResults loads the frozen standings. Competitions closed before
+// results were stored fall back to ranking their participant rows under
+// the original, default XP policy.
+func storedResults(repo ports.CompetitionRepository, cmp *competition.Competition) ([]competition.Result, error) {
+	results, err := repo.GetResults(cmp.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 && len(cmp.Participants) > 0 {
+		results = resultsOf(rankWinners(cmp, xp.DefaultPolicy()))
+	}
+	return results, nil
+}
+
+func resultsOf(winners []Winner) []competition.Result {
+	out := make([]competition.Result, 0, len(winners))
+	for _, w := range winners {
+		out = append(out, competition.Result{
+			UserID:       w.UserID,
+			Rank:         w.Rank,
+			Points:       w.Points,
+			DaysRead:     w.DaysRead,
+			MinutesTotal: w.MinutesTotal,
+			XPEarned:     w.XPEarned,
+			Group:        w.Group,
+			TeamID:       w.TeamID,
+			TeamName:     w.TeamName,
+			TeamRank:     w.TeamRank,
+		})
+	}
+	return out
+}
+
+func winnersOf(results []competition.Result) []Winner {
+	out := make([]Winner, 0, len(results))
+	for _, r := range results {
+		out = append(out, Winner{
+			UserID:       r.UserID,
+			Points:       r.Points,
+			DaysRead:     r.DaysRead,
+			MinutesTotal: r.MinutesTotal,
+			Rank:         r.Rank,
+			XPEarned:     r.XPEarned,
+			Group:        r.Group,
+			TeamID:       r.TeamID,
+			TeamName:     r.TeamName,
+			TeamRank:     r.TeamRank,
+		})
+	}
+	return out
+}
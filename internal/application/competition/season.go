@@ -0,0 +1,199 @@
+package competition
+
+import (
+	"log"
+	"time"
+
+	appXP "github.com/bakhtybayevn/powerbook/internal/application/xp"
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/competition"
+	"github.com/bakhtybayevn/powerbook/internal/domain/user"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// -------------------------------------
+// CREATE SEASON
+// -------------------------------------
+
+type CreateSeasonCommand struct {
+	ActorID   string
+	SeriesID  string
+	Name      string
+	StartDate time.Time
+	EndDate   time.Time
+}
+
+type CreateSeasonHandler struct {
+	SeriesRepo ports.SeriesRepository
+	UserRepo   ports.UserRepository
+}
+
+func NewCreateSeasonHandler(seriesRepo ports.SeriesRepository, userRepo ports.UserRepository) *CreateSeasonHandler {
+	return &CreateSeasonHandler{SeriesRepo: seriesRepo, UserRepo: userRepo}
+}
+
+func (h *CreateSeasonHandler) Handle(cmd CreateSeasonCommand) (*competition.Season, error) {
+	s, err := h.SeriesRepo.GetSeries(cmd.SeriesID)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireSeriesOwner(s, cmd.ActorID, h.UserRepo); err != nil {
+		return nil, err
+	}
+
+	season, err := competition.NewSeason(s.ID, cmd.Name, cmd.StartDate, cmd.EndDate)
+	if err != nil {
+		return nil, core.New(core.ValidationError, err.Error())
+	}
+
+	if err := h.SeriesRepo.SaveSeason(season); err != nil {
+		return nil, err
+	}
+	return season, nil
+}
+
+// -------------------------------------
+// SEASON STANDINGS
+// -------------------------------------
+
+type SeasonStandingsHandler struct {
+	SeriesRepo ports.SeriesRepository
+	CompRepo   ports.CompetitionRepository
+}
+
+func NewSeasonStandingsHandler(seriesRepo ports.SeriesRepository, compRepo ports.CompetitionRepository) *SeasonStandingsHandler {
+	return &SeasonStandingsHandler{SeriesRepo: seriesRepo, CompRepo: compRepo}
+}
+
+// Handle returns the final table of a closed season, or the live table
+// computed from the series' competitions while it runs.
+func (h *SeasonStandingsHandler) Handle(seasonID string) (*competition.Season, []competition.SeasonStanding, error) {
+	season, err := h.SeriesRepo.GetSeason(seasonID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if season.Status == competition.SeasonClosed {
+		rows, err := h.SeriesRepo.GetSeasonStandings(season.ID)
+		return season, rows, err
+	}
+
+	comps, err := h.CompRepo.FindBySeries(season.SeriesID)
+	if err != nil {
+		return nil, nil, err
+	}
+	results, err := finalResults(h.CompRepo, season, comps)
+	if err != nil {
+		return nil, nil, err
+	}
+	return season, season.Standings(comps, results), nil
+}
+
+// finalResults loads the stored standings of the season's closed
+// competitions, keyed by competition id.
+func finalResults(repo ports.CompetitionRepository, season *competition.Season, comps []*competition.Competition) (map[string][]competition.Result, error) {
+	out := map[string][]competition.Result{}
+	for _, c := range comps {
+		if !season.Includes(c) || c.Status != competition.StatusClosed {
+			continue
+		}
+		rows, err := repo.GetResults(c.ID)
+		if err != nil {
+			return nil, err
+		}
+		if len(rows) > 0 { // closed before final standings were stored
+			out[c.ID] = rows
+		}
+	}
+	return out, nil
+}
+
+// -------------------------------------
+// CLOSE ENDED SEASONS (scheduler)
+// -------------------------------------
+
+type CloseSeasonsHandler struct {
+	SeriesRepo ports.SeriesRepository
+	CompRepo   ports.CompetitionRepository
+	Policies   ports.XPPolicyRepository
+	UoW        ports.UnitOfWork // the close, the table and every award commit together
+}
+
+func NewCloseSeasonsHandler(
+	seriesRepo ports.SeriesRepository,
+	compRepo ports.CompetitionRepository,
+	policies ports.XPPolicyRepository,
+	uow ports.UnitOfWork,
+) *CloseSeasonsHandler {
+	return &CloseSeasonsHandler{SeriesRepo: seriesRepo, CompRepo: compRepo, Policies: policies, UoW: uow}
+}
+
+// Handle closes every season whose window has passed and whose competitions
+// are all finished, freezing the table and awarding season-end XP.
+func (h *CloseSeasonsHandler) Handle(now time.Time) (int, error) {
+	seasons, err := h.SeriesRepo.ListOpenSeasonsEndedBefore(now)
+	if err != nil {
+		return 0, err
+	}
+
+	closed := 0
+	for _, season := range seasons {
+		comps, err := h.CompRepo.FindBySeries(season.SeriesID)
+		if err != nil {
+			log.Printf("[Seasons] %s: failed to load competitions: %v", season.ID, err)
+			continue
+		}
+		if !season.ReadyToClose(comps, now) {
+			continue
+		}
+
+		// XP is awarded under the policy in force when the close starts
+		policy, err := h.Policies.Current()
+		if err != nil {
+			return closed, err
+		}
+		results, err := finalResults(h.CompRepo, season, comps)
+		if err != nil {
+			log.Printf("[Seasons] %s: failed to load results: %v", season.ID, err)
+			continue
+		}
+		rows := season.Standings(comps, results)
+		for i := range rows {
+			rows[i].XPEarned = policy.SeasonXP(rows[i].Rank, len(rows), rows[i].Wins)
+		}
+
+		claimed := false
+		err = h.UoW.Do(func(tx ports.Repositories) error {
+			// claim the season so a concurrent run can't award it twice
+			ok, err := tx.Series.CloseSeason(season.ID, policy.Version)
+			if err != nil || !ok {
+				return err
+			}
+			claimed = true
+			if err := tx.Series.SaveSeasonStandings(season.ID, rows); err != nil {
+				return err
+			}
+
+			for _, r := range rows {
+				u, err := tx.Users.Get(r.UserID)
+				if err != nil {
+					continue // deleted account
+				}
+				u.AddXP(r.XPEarned, user.XPSeason, user.XPSource{SeasonID: season.ID})
+				if _, err := appXP.SaveUser(tx, u); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			log.Printf("[Seasons] %s: failed to close: %v", season.ID, err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+		closed++
+	}
+	return closed, nil
+}
@@ -0,0 +1,81 @@
+package competition
+
+import (
+	"testing"
+
+	"github.com/bakhtybayevn/powerbook/internal/domain/competition"
+)
+
+func TestSeasonStandingsUseFinalResults(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(t *testing.T) *closeFixture
+		wantWins map[string]int
+	}{
+		{
+			name: "a full tie goes the way the close ranked it",
+			setup: func(t *testing.T) *closeFixture {
+				f := newCloseFixture(t, competition.FormatIndividual)
+				f.join(t, "ann", "", 100, 5)
+				f.join(t, "bob", "", 100, 5)
+				f.join(t, "cat", "", 100, 5)
+				return f
+			},
+		},
+		{
+			name: "the winning team wins, not the best reader",
+			setup: func(t *testing.T) *closeFixture {
+				f := newCloseFixture(t, competition.FormatTeam, "Owls", "Foxes")
+				f.join(t, "ann", "Owls", 60, 3)
+				f.join(t, "amy", "Owls", 60, 3)
+				f.join(t, "bob", "Foxes", 100, 5)
+				return f
+			},
+			wantWins: map[string]int{"ann": 1, "amy": 1, "bob": 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := tt.setup(t)
+			season, err := competition.NewSeason(fixtureSeriesID, "Winter", f.cmp.StartDate.AddDate(0, 0, -1), f.cmp.EndDate.AddDate(0, 0, 1))
+			if err != nil {
+				t.Fatalf("new season: %v", err)
+			}
+			if err := f.repos.Series.SaveSeason(season); err != nil {
+				t.Fatalf("save season: %v", err)
+			}
+
+			winners, _, err := f.handler().Handle(CloseCompetitionCommand{CompetitionID: f.cmp.ID})
+			if err != nil {
+				t.Fatalf("close: %v", err)
+			}
+			wantWins := tt.wantWins
+			if wantWins == nil {
+				wantWins = map[string]int{}
+				for _, w := range winners {
+					wantWins[nameOf(f, w.UserID)] = 0
+					if w.Rank == 1 {
+						wantWins[nameOf(f, w.UserID)] = 1
+					}
+				}
+			}
+
+			h := NewSeasonStandingsHandler(f.repos.Series, f.repos.Competitions)
+			for i := 0; i < 20; i++ {
+				_, rows, err := h.Handle(season.ID)
+				if err != nil {
+					t.Fatalf("standings: %v", err)
+				}
+				if len(rows) != len(wantWins) {
+					t.Fatalf("got %d rows, want %d", len(rows), len(wantWins))
+				}
+				for _, r := range rows {
+					if want := wantWins[nameOf(f, r.UserID)]; r.Wins != want {
+						t.Fatalf("call %d: %s has %d wins, want %d", i, nameOf(f, r.UserID), r.Wins, want)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package competition
+
+import (
+	"log"
+	"time"
+
+	appXP "github.com/bakhtybayevn/powerbook/internal/application/xp"
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/competition"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// SeriesLeadTime is how long before its start a recurring instance is created.
+const SeriesLeadTime = 24 * time.Hour
+
+// -------------------------------------
+// CREATE SERIES
+// -------------------------------------
+
+type CreateSeriesCommand struct {
+	UserID     string // becomes the owner of the series and its instances
+	Name       string
+	Recurrence string // "weekly" or "monthly"
+	FirstStart time.Time
+
+	PointsPerMinute int
+	ScoringType     string
+	PointsPerUnit   int
+	DailyMinuteCap  int
+
+	Format           string
+	Visibility       string
+	RequiresApproval bool
+	MaxParticipants  int
+}
+
+type CreateSeriesHandler struct {
+	SeriesRepo ports.SeriesRepository
+	UserRepo   ports.UserRepository
+	Perks      *appXP.PerksReader
+}
+
+func NewCreateSeriesHandler(seriesRepo ports.SeriesRepository, userRepo ports.UserRepository, perks *appXP.PerksReader) *CreateSeriesHandler {
+	return &CreateSeriesHandler{SeriesRepo: seriesRepo, UserRepo: userRepo, Perks: perks}
+}
+
+func (h *CreateSeriesHandler) Handle(cmd CreateSeriesCommand) (*competition.Series, error) {
+	if cmd.UserID == "" {
+		return nil, core.New(core.AuthError, "unauthorized")
+	}
+	if cmd.FirstStart.IsZero() {
+		return nil, core.New(core.ValidationError, "first_start is required")
+	}
+
+	rules := competition.Rules{
+		Type:            competition.ScoringType(cmd.ScoringType),
+		PointsPerMinute: cmd.PointsPerMinute,
+		PointsPerUnit:   cmd.PointsPerUnit,
+		DailyMinuteCap:  cmd.DailyMinuteCap,
+	}
+
+	s, err := competition.NewSeries(cmd.Name, cmd.UserID, competition.Recurrence(cmd.Recurrence), cmd.FirstStart, rules)
+	if err != nil {
+		return nil, core.New(core.ValidationError, err.Error())
+	}
+
+	// validate the rest of the template by building a throwaway instance
+	probe, _ := competition.NewCompetition(s.Name, s.NextStart, s.PeriodEnd(s.NextStart), rules)
+	if err := probe.SetFormat(competition.Format(cmd.Format)); err != nil {
+		return nil, core.New(core.ValidationError, err.Error())
+	}
+	visibility := competition.Visibility(cmd.Visibility)
+	if visibility == "" {
+		if visibility, err = defaultVisibility(cmd.UserID, h.UserRepo, h.Perks); err != nil {
+			return nil, err
+		}
+	}
+	if err := probe.SetVisibility(visibility); err != nil {
+		return nil, core.New(core.ValidationError, err.Error())
+	}
+	// every instance would be a public competition
+	if probe.Visibility == competition.VisibilityPublic {
+		if err := requirePublicPerk(cmd.UserID, h.UserRepo, h.Perks); err != nil {
+			return nil, err
+		}
+	}
+	if err := probe.SetMaxParticipants(cmd.MaxParticipants); err != nil {
+		return nil, core.New(core.ValidationError, err.Error())
+	}
+	s.Format = probe.Format
+	s.Visibility = probe.Visibility
+	s.RequiresApproval = cmd.RequiresApproval
+	s.MaxParticipants = cmd.MaxParticipants
+
+	if err := h.SeriesRepo.SaveSeries(s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// -------------------------------------
+// STOP SERIES
+// -------------------------------------
+
+type StopSeriesCommand struct {
+	ActorID  string
+	SeriesID string
+}
+
+type StopSeriesHandler struct {
+	SeriesRepo ports.SeriesRepository
+	UserRepo   ports.UserRepository
+}
+
+func NewStopSeriesHandler(seriesRepo ports.SeriesRepository, userRepo ports.UserRepository) *StopSeriesHandler {
+	return &StopSeriesHandler{SeriesRepo: seriesRepo, UserRepo: userRepo}
+}
+
+// Handle stops creating new instances; existing ones run to their end.
+func (h *StopSeriesHandler) Handle(cmd StopSeriesCommand) (*competition.Series, error) {
+	s, err := h.SeriesRepo.GetSeries(cmd.SeriesID)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireSeriesOwner(s, cmd.ActorID, h.UserRepo); err != nil {
+		return nil, err
+	}
+
+	s.Active = false
+	if err := h.SeriesRepo.SaveSeries(s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// -------------------------------------
+// SPAWN DUE INSTANCES (scheduler)
+// -------------------------------------
+
+type SpawnSeriesInstancesHandler struct {
+	SeriesRepo ports.SeriesRepository
+	UoW        ports.UnitOfWork // new instances and the advanced cursor commit together
+}
+
+func NewSpawnSeriesInstancesHandler(seriesRepo ports.SeriesRepository, uow ports.UnitOfWork) *SpawnSeriesInstancesHandler {
+	return &SpawnSeriesInstancesHandler{SeriesRepo: seriesRepo, UoW: uow}
+}
+
+// Handle creates the next competition of every series that is due and
+// returns how many were created. One failing series doesn't stop the rest.
+func (h *SpawnSeriesInstancesHandler) Handle(now time.Time) (int, error) {
+	list, err := h.SeriesRepo.ListActiveSeries()
+	if err != nil {
+		return 0, err
+	}
+
+	created := 0
+	for _, s := range list {
+		n := 0
+		err := h.UoW.Do(func(tx ports.Repositories) error {
+			n = 0
+			s.SkipMissed(now)
+			for s.Due(now, SeriesLeadTime) {
+				cmp, err := s.Spawn()
+				if err != nil {
+					return err
+				}
+				if err := tx.Competitions.Create(cmp); err != nil {
+					return err
+				}
+				n++
+			}
+			return tx.Series.SaveSeries(s)
+		})
+		if err != nil {
+			log.Printf("[Series] %s: failed to create instances: %v", s.ID, err)
+			continue
+		}
+		created += n
+	}
+	return created, nil
+}
+
+func requireSeriesOwner(s *competition.Series, actorID string, users ports.UserRepository) error {
+	if actorID == "" {
+		return core.New(core.AuthError, "unauthorized")
+	}
+	if s.OwnerID == actorID || isAdmin(actorID, users) {
+		return nil
+	}
+	return core.New(core.AuthError, "only the series owner can do this")
+}
@@ -0,0 +1,187 @@
+package competition
+
+import (
+	"time"
+
+	appXP "github.com/bakhtybayevn/powerbook/internal/application/xp"
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/competition"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// UpdateCompetitionCommand carries a partial update; nil fields stay as they are.
+type UpdateCompetitionCommand struct {
+	ActorID       string
+	CompetitionID string
+
+	Name      *string
+	StartDate *time.Time
+	EndDate   *time.Time
+
+	// rules: only before the competition starts
+	PointsPerMinute *int
+	ScoringType     *string
+	PointsPerUnit   *int
+	DailyMinuteCap  *int
+
+	Visibility       *string
+	RequiresApproval *bool
+	MaxParticipants  *int
+}
+
+func (cmd UpdateCompetitionCommand) changesRules() bool {
+	return cmd.PointsPerMinute != nil || cmd.ScoringType != nil || cmd.PointsPerUnit != nil || cmd.DailyMinuteCap != nil
+}
+
+type UpdateCompetitionHandler struct {
+	UoW      ports.UnitOfWork // a close or cancel cannot slip in between the check and the write
+	UserRepo ports.UserRepository
+	Perks    *appXP.PerksReader
+}
+
+func NewUpdateCompetitionHandler(uow ports.UnitOfWork, userRepo ports.UserRepository, perks *appXP.PerksReader) *UpdateCompetitionHandler {
+	return &UpdateCompetitionHandler{UoW: uow, UserRepo: userRepo, Perks: perks}
+}
+
+func (h *UpdateCompetitionHandler) Handle(cmd UpdateCompetitionCommand) (*competition.Competition, error) {
+	if cmd.CompetitionID == "" {
+		return nil, core.New(core.ValidationError, "competition id is required")
+	}
+
+	var cmp *competition.Competition
+	err := h.UoW.Do(func(tx ports.Repositories) error {
+		var err error
+		cmp, err = h.update(tx.Competitions, cmd)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cmp, nil
+}
+
+func (h *UpdateCompetitionHandler) update(repo ports.CompetitionRepository, cmd UpdateCompetitionCommand) (*competition.Competition, error) {
+	cmp, err := repo.GetForUpdate(cmd.CompetitionID)
+	if err != nil {
+		return nil, core.New(core.NotFoundError, "competition not found")
+	}
+	if err := requireOrganizer(cmp, cmd.ActorID, h.UserRepo); err != nil {
+		return nil, err
+	}
+	if !cmp.IsOpen() {
+		return nil, core.New(core.ValidationError, "competition is "+string(cmp.Status))
+	}
+
+	now := time.Now().UTC()
+
+	if cmd.Name != nil {
+		if err := cmp.Rename(*cmd.Name); err != nil {
+			return nil, core.New(core.ValidationError, err.Error())
+		}
+	}
+
+	if cmd.StartDate != nil || cmd.EndDate != nil {
+		start, end := cmp.StartDate, cmp.EndDate
+		if cmd.StartDate != nil {
+			start = *cmd.StartDate
+		}
+		if cmd.EndDate != nil {
+			end = *cmd.EndDate
+		}
+		if err := cmp.Reschedule(start, end, now); err != nil {
+			return nil, core.New(core.ValidationError, err.Error())
+		}
+	}
+
+	if cmd.changesRules() {
+		rules := cmp.Rules
+		if cmd.PointsPerMinute != nil {
+			rules.PointsPerMinute = *cmd.PointsPerMinute
+		}
+		if cmd.ScoringType != nil {
+			rules.Type = competition.ScoringType(*cmd.ScoringType)
+		}
+		if cmd.PointsPerUnit != nil {
+			rules.PointsPerUnit = *cmd.PointsPerUnit
+		}
+		if cmd.DailyMinuteCap != nil {
+			rules.DailyMinuteCap = *cmd.DailyMinuteCap
+		}
+		if err := cmp.ChangeRules(rules, now); err != nil {
+			return nil, core.New(core.ValidationError, err.Error())
+		}
+	}
+
+	if cmd.Visibility != nil {
+		wasPublic := cmp.Visibility == competition.VisibilityPublic
+		if err := cmp.SetVisibility(competition.Visibility(*cmd.Visibility)); err != nil {
+			return nil, core.New(core.ValidationError, err.Error())
+		}
+		if !wasPublic && cmp.Visibility == competition.VisibilityPublic {
+			if err := requirePublicPerk(cmd.ActorID, h.UserRepo, h.Perks); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if cmd.RequiresApproval != nil {
+		cmp.RequiresApproval = *cmd.RequiresApproval
+	}
+	if cmd.MaxParticipants != nil {
+		if err := cmp.SetMaxParticipants(*cmd.MaxParticipants); err != nil {
+			return nil, core.New(core.ValidationError, err.Error())
+		}
+	}
+
+	if err := repo.Save(cmp); err != nil {
+		return nil, core.New(core.ServerError, "failed to save competition")
+	}
+	return cmp, nil
+}
+
+// -------------------------------------
+// CANCEL
+// -------------------------------------
+
+type CancelCompetitionCommand struct {
+	ActorID       string
+	CompetitionID string
+}
+
+type CancelCompetitionHandler struct {
+	Repo     ports.CompetitionRepository
+	UserRepo ports.UserRepository
+}
+
+func NewCancelCompetitionHandler(repo ports.CompetitionRepository, userRepo ports.UserRepository) *CancelCompetitionHandler {
+	return &CancelCompetitionHandler{Repo: repo, UserRepo: userRepo}
+}
+
+// Handle stops the competition for good. Unlike close, nobody is ranked,
+// no XP is awarded and no gifts are paired; standings stay as they were.
+func (h *CancelCompetitionHandler) Handle(cmd CancelCompetitionCommand) (*competition.Competition, error) {
+	if cmd.CompetitionID == "" {
+		return nil, core.New(core.ValidationError, "competition id is required")
+	}
+
+	cmp, err := h.Repo.Get(cmd.CompetitionID)
+	if err != nil {
+		return nil, core.New(core.NotFoundError, "competition not found")
+	}
+	if err := requireOrganizer(cmp, cmd.ActorID, h.UserRepo); err != nil {
+		return nil, err
+	}
+
+	if err := cmp.Cancel(); err != nil {
+		return nil, core.New(core.ValidationError, err.Error())
+	}
+
+	// conditional, so a close that got there first is never overwritten
+	cancelled, err := h.Repo.TransitionStatus(cmp.ID, competition.StatusOpen, competition.StatusCancelled)
+	if err != nil {
+		return nil, core.New(core.ServerError, "failed to save competition")
+	}
+	if !cancelled {
+		return nil, core.New(core.ValidationError, "competition is no longer open")
+	}
+	return cmp, nil
+}
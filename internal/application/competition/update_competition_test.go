@@ -0,0 +1,51 @@
+package competition
+
+import (
+	"testing"
+
+	"github.com/bakhtybayevn/powerbook/internal/adapters/memory"
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/competition"
+)
+
+func TestUpdateCompetitionKeepsStatus(t *testing.T) {
+	for _, status := range []competition.Status{competition.StatusClosed, competition.StatusCancelled} {
+		t.Run(string(status), func(t *testing.T) {
+			f := newCloseFixture(t, competition.FormatIndividual)
+			stale, err := f.repos.Competitions.Get(f.cmp.ID)
+			if err != nil {
+				t.Fatalf("get competition: %v", err)
+			}
+			if _, err := f.repos.Competitions.TransitionStatus(f.cmp.ID, competition.StatusOpen, status); err != nil {
+				t.Fatalf("transition: %v", err)
+			}
+
+			name := "Renamed"
+			uow := memory.NewUnitOfWork(f.repos)
+			_, err = NewUpdateCompetitionHandler(uow, f.repos.Users, nil).Handle(UpdateCompetitionCommand{
+				ActorID: f.users["owner"], CompetitionID: f.cmp.ID, Name: &name,
+			})
+			if !core.Is(err, core.ValidationError) {
+				t.Errorf("update err = %v, want a validation error", err)
+			}
+			if _, err := NewRegenerateInviteCodeHandler(uow, f.repos.Users).Handle(RegenerateInviteCodeCommand{
+				ActorID: f.users["owner"], CompetitionID: f.cmp.ID,
+			}); !core.Is(err, core.ValidationError) {
+				t.Errorf("regenerate err = %v, want a validation error", err)
+			}
+
+			// a copy loaded while it was still open cannot reopen it
+			stale.Name = name
+			if err := f.repos.Competitions.Save(stale); err != nil {
+				t.Fatalf("save stale copy: %v", err)
+			}
+			got, err := f.repos.Competitions.Get(f.cmp.ID)
+			if err != nil {
+				t.Fatalf("get competition: %v", err)
+			}
+			if got.Status != status {
+				t.Errorf("status = %s, want %s", got.Status, status)
+			}
+		})
+	}
+}
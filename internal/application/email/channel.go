@@ -0,0 +1,39 @@
+package email
+
+import (
+	"github.com/bakhtybayevn/powerbook/internal/domain/notification"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// Channel emails notifications. Being overtaken happens too often to be
+// worth an email, so those stay in the inbox and chat.
+type Channel struct {
+	Users  ports.UserRepository
+	Mailer *Mailer
+}
+
+func NewChannel(users ports.UserRepository, mailer *Mailer) *Channel {
+	return &Channel{Users: users, Mailer: mailer}
+}
+
+func (c *Channel) Channel() notification.Channel {
+	return notification.ChannelEmail
+}
+
+func (c *Channel) Deliver(n *notification.Notification) error {
+	if n.Kind == notification.KindOvertaken {
+		return nil
+	}
+
+	u, err := c.Users.Get(n.UserID)
+	if err != nil {
+		return err
+	}
+
+	template := TemplateNotification
+	if n.Kind == notification.KindGiftPairing {
+		template = TemplateGiftPairing
+	}
+	data := map[string]string{"Name": u.DisplayName, "Title": n.Title, "Body": n.Body}
+	return c.Mailer.Send(u.Email, template, data, "notification:"+n.ID)
+}
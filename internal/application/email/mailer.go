@@ -0,0 +1,85 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/email"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// Mailer renders messages and puts them in the outbox; the Relay sends them.
+// Going through the outbox means a mail server outage delays mail instead
+// of losing it.
+type Mailer struct {
+	Outbox ports.EmailOutboxRepository
+}
+
+func NewMailer(outbox ports.EmailOutboxRepository) *Mailer {
+	return &Mailer{Outbox: outbox}
+}
+
+// Send queues the named template for to. A non-empty key keeps the message
+// from being queued twice.
+func (m *Mailer) Send(to, template string, data any, key string) error {
+	msg, err := m.Compose(to, template, data, key)
+	if err != nil {
+		return err
+	}
+	_, err = m.Outbox.Enqueue(msg)
+	return err
+}
+
+// Compose renders the named template into an outbox message without queueing
+// it, for use cases that enqueue it in their own unit of work.
+func (m *Mailer) Compose(to, template string, data any, key string) (*email.OutboxMessage, error) {
+	msg, err := Render(template, to, data)
+	if err != nil {
+		return nil, core.New(core.ServerError, err.Error())
+	}
+	return email.NewOutboxMessage(msg, key, time.Now().UTC()), nil
+}
+
+const (
+	relayBatch = 20
+	relayLease = 5 * time.Minute // longer than a batch can take to send
+)
+
+// Relay sends what is due in the outbox and schedules retries for what fails.
+type Relay struct {
+	Outbox ports.EmailOutboxRepository
+	Sender ports.EmailSender
+}
+
+func NewRelay(outbox ports.EmailOutboxRepository, sender ports.EmailSender) *Relay {
+	return &Relay{Outbox: outbox, Sender: sender}
+}
+
+// Handle works through the due messages in batches and returns how many it sent.
+func (r *Relay) Handle(now time.Time) (int, error) {
+	sent := 0
+	for {
+		batch, err := r.Outbox.ClaimDue(now, relayBatch, relayLease)
+		if err != nil {
+			return sent, err
+		}
+		for _, m := range batch {
+			if err := r.Sender.Send(context.Background(), m.Message); err != nil {
+				m.MarkFailed(err.Error(), errors.Is(err, ports.ErrEmailRejected), time.Now().UTC())
+				log.Printf("[Email] failed to send %s (attempt %d): %v", m.ID, m.Attempts, err)
+			} else {
+				m.MarkSent(time.Now().UTC())
+				sent++
+			}
+			if err := r.Outbox.Save(m); err != nil {
+				return sent, err
+			}
+		}
+		if len(batch) < relayBatch {
+			return sent, nil
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package email
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/bakhtybayevn/powerbook/internal/domain/email"
+)
+
+// Template names. Each has a <name>.txt and a <name>.html file; both define
+// a "subject" block, and the HTML one a "content" block for layout.html.
+const (
+	TemplateNotification  = "notification"
+	TemplateGiftPairing   = "gift_pairing"
+	TemplateWeeklySummary = "weekly_summary"
+	TemplatePasswordReset = "password_reset"
+)
+
+//go:embed templates
+var templateFiles embed.FS
+
+type template struct {
+	text *texttemplate.Template
+	html *htmltemplate.Template
+}
+
+var templates = mustParseTemplates()
+
+func mustParseTemplates() map[string]template {
+	names, err := fs.Glob(templateFiles, "templates/*.txt")
+	if err != nil {
+		panic(err)
+	}
+
+	out := make(map[string]template, len(names))
+	for _, path := range names {
+		name := strings.TrimSuffix(strings.TrimPrefix(path, "templates/"), ".txt")
+		out[name] = template{
+			text: texttemplate.Must(texttemplate.ParseFS(templateFiles, path)),
+			html: htmltemplate.Must(htmltemplate.ParseFS(templateFiles, "templates/layout.html", "templates/"+name+".html")),
+		}
+	}
+	return out
+}
+
+// Render fills the named template with data into a message for to.
+func Render(name, to string, data any) (email.Message, error) {
+	t, ok := templates[name]
+	if !ok {
+		return email.Message{}, fmt.Errorf("email template %q not found", name)
+	}
+
+	var subject, text, html bytes.Buffer
+	if err := t.text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return email.Message{}, fmt.Errorf("email template %q: %w", name, err)
+	}
+	if err := t.text.Execute(&text, data); err != nil {
+		return email.Message{}, fmt.Errorf("email template %q: %w", name, err)
+	}
+	if err := t.html.ExecuteTemplate(&html, "layout.html", data); err != nil {
+		return email.Message{}, fmt.Errorf("email template %q: %w", name, err)
+	}
+
+	return email.Message{
+		To:      to,
+		Subject: strings.TrimSpace(subject.String()),
+		Text:    text.String(),
+		HTML:    html.String(),
+	}, nil
+}
@@ -0,0 +1,93 @@
+package email
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bakhtybayevn/powerbook/internal/domain/calendar"
+	"github.com/bakhtybayevn/powerbook/internal/domain/notification"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// WeeklySummaryHandler emails each reader a summary of the last full week,
+// Monday to Sunday UTC.
+type WeeklySummaryHandler struct {
+	Users       ports.UserRepository
+	Readings    ports.ReadingRepository
+	Preferences ports.NotificationPreferenceRepository
+	Mailer      *Mailer
+
+	lastWeek string // the week already handled by this process
+}
+
+func NewWeeklySummaryHandler(
+	users ports.UserRepository,
+	readings ports.ReadingRepository,
+	preferences ports.NotificationPreferenceRepository,
+	mailer *Mailer,
+) *WeeklySummaryHandler {
+	return &WeeklySummaryHandler{Users: users, Readings: readings, Preferences: preferences, Mailer: mailer}
+}
+
+// Handle queues the summaries of the week before now and returns how many
+// it queued. Users who didn't read that week or turned email off are
+// skipped. The scheduler calls it every tick: after the first call of a week
+// it returns at once, and the outbox key keeps restarts from sending twice.
+func (h *WeeklySummaryHandler) Handle(now time.Time) (int, error) {
+	today := calendar.Day(now, time.UTC)
+	to := today.AddDate(0, 0, -((int(today.Weekday()) + 6) % 7)) // this Monday
+	from := to.AddDate(0, 0, -7)
+	year, week := from.ISOWeek()
+	weekKey := fmt.Sprintf("%d-W%02d", year, week)
+	if weekKey == h.lastWeek {
+		return 0, nil
+	}
+
+	users, err := h.Users.ListAll()
+	if err != nil {
+		return 0, err
+	}
+
+	queued := 0
+	for _, u := range users {
+		prefs, err := h.Preferences.Get(u.ID)
+		if err != nil {
+			return queued, err
+		}
+		if !prefs.Enabled(notification.ChannelEmail) {
+			continue
+		}
+
+		logs, err := h.Readings.ListByDateRange(u.ID, from, to)
+		if err != nil {
+			return queued, err
+		}
+		if len(logs) == 0 {
+			continue
+		}
+		minutes := 0
+		days := map[time.Time]bool{}
+		for _, r := range logs {
+			minutes += r.Minutes
+			days[calendar.Day(r.Timestamp, u.Location())] = true
+		}
+
+		data := map[string]any{
+			"Name":     u.DisplayName,
+			"From":     from.Format("Jan 2"),
+			"To":       to.AddDate(0, 0, -1).Format("Jan 2"),
+			"Minutes":  minutes,
+			"Sessions": len(logs),
+			"DaysRead": len(days),
+			"Streak":   u.StreakCurrentDays,
+			"XP":       u.XP,
+		}
+		if err := h.Mailer.Send(u.Email, TemplateWeeklySummary, data, "weekly_summary:"+u.ID+":"+weekKey); err != nil {
+			return queued, err
+		}
+		queued++
+	}
+
+	h.lastWeek = weekKey
+	return queued, nil
+}
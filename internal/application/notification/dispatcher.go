@@ -0,0 +1,61 @@
+package notification
+
+import (
+	"log"
+
+	"github.com/bakhtybayevn/powerbook/internal/domain/notification"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// Dispatcher stores every notification in the recipient's inbox and then
+// delivers it on the channels the recipient has enabled.
+type Dispatcher struct {
+	Inbox       ports.NotificationRepository
+	Preferences ports.NotificationPreferenceRepository
+	Channels    []ports.NotificationChannel
+}
+
+func NewDispatcher(
+	inbox ports.NotificationRepository,
+	preferences ports.NotificationPreferenceRepository,
+	channels ...ports.NotificationChannel,
+) *Dispatcher {
+	return &Dispatcher{Inbox: inbox, Preferences: preferences, Channels: channels}
+}
+
+// Notify saves to the inbox right away; outside channels are reached in the
+// background so a slow one never holds up the caller. Failures are logged.
+func (d *Dispatcher) Notify(ns ...*notification.Notification) {
+	var deliver []*notification.Notification
+	for _, n := range ns {
+		saved, err := d.Inbox.Save(n)
+		if err != nil {
+			log.Printf("[Notifications] failed to save %s for user %s: %v", n.Kind, n.UserID, err)
+			continue
+		}
+		if saved { // a keyed notification the user already got is skipped
+			deliver = append(deliver, n)
+		}
+	}
+	if len(deliver) > 0 && len(d.Channels) > 0 {
+		go d.deliver(deliver)
+	}
+}
+
+func (d *Dispatcher) deliver(ns []*notification.Notification) {
+	for _, n := range ns {
+		prefs, err := d.Preferences.Get(n.UserID)
+		if err != nil {
+			log.Printf("[Notifications] failed to load preferences of user %s: %v", n.UserID, err)
+			continue
+		}
+		for _, ch := range d.Channels {
+			if !prefs.Enabled(ch.Channel()) {
+				continue
+			}
+			if err := ch.Deliver(n); err != nil {
+				log.Printf("[Notifications] %s delivery to user %s failed: %v", ch.Channel(), n.UserID, err)
+			}
+		}
+	}
+}
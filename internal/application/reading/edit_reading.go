@@ -0,0 +1,133 @@
+package reading
+
+import (
+	"time"
+
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/reading"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+type UpdateReadingCommand struct {
+	UserID       string
+	ReadingID    string
+	Minutes      int
+	Source       string
+	Timestamp    *time.Time // nil keeps the original time
+	BookID       string
+	PagesRead    int
+	FinishedBook bool
+}
+
+type UpdateReadingHandler struct {
+	BookRepo     ports.BookRepository
+	Recalculator *StatsRecalculator
+}
+
+func NewUpdateReadingHandler(bookRepo ports.BookRepository, recalc *StatsRecalculator) *UpdateReadingHandler {
+	return &UpdateReadingHandler{BookRepo: bookRepo, Recalculator: recalc}
+}
+
+func (h *UpdateReadingHandler) Handle(cmd UpdateReadingCommand) (newStreak int, totalMinutes int, err error) {
+	// validation
+	if cmd.ReadingID == "" {
+		return 0, 0, core.New(core.ValidationError, "reading id is required")
+	}
+	if cmd.Minutes <= 0 {
+		return 0, 0, core.New(core.ValidationError, "minutes must be > 0")
+	}
+	if cmd.Minutes > 1440 {
+		return 0, 0, core.New(core.ValidationError, "minutes cannot exceed 1440 (24 hours)")
+	}
+	if err := validateBook(h.BookRepo, cmd.BookID, cmd.PagesRead, cmd.FinishedBook); err != nil {
+		return 0, 0, err
+	}
+
+	u, err := h.Recalculator.Recalculate(cmd.UserID, func(tx ports.Repositories) error {
+		rd, err := loadOwnedReading(tx.Readings, cmd.UserID, cmd.ReadingID)
+		if err != nil {
+			return err
+		}
+
+		ts := rd.Timestamp
+		if cmd.Timestamp != nil {
+			ts = cmd.Timestamp.UTC()
+			if ts.After(time.Now().UTC()) {
+				return core.New(core.ValidationError, "timestamp cannot be in the future")
+			}
+		}
+
+		u, err := tx.Users.Get(cmd.UserID)
+		if err != nil {
+			return core.New(core.NotFoundError, "user not found")
+		}
+
+		if err := checkDailyCap(tx.Readings, cmd.UserID, ts, u.Location(), cmd.Minutes, rd.ID); err != nil {
+			return err
+		}
+
+		rd.Minutes = cmd.Minutes
+		rd.Timestamp = ts
+		rd.WithBook(cmd.BookID, cmd.PagesRead, cmd.FinishedBook)
+		if cmd.Source != "" {
+			rd.Source = cmd.Source
+		}
+
+		if err := tx.Readings.Update(rd); err != nil {
+			return core.New(core.ServerError, "failed to update reading")
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return u.StreakCurrentDays, u.TotalMinutes, nil
+}
+
+// -------------------------------------
+
+type DeleteReadingCommand struct {
+	UserID    string
+	ReadingID string
+}
+
+type DeleteReadingHandler struct {
+	Recalculator *StatsRecalculator
+}
+
+func NewDeleteReadingHandler(recalc *StatsRecalculator) *DeleteReadingHandler {
+	return &DeleteReadingHandler{Recalculator: recalc}
+}
+
+func (h *DeleteReadingHandler) Handle(cmd DeleteReadingCommand) (newStreak int, totalMinutes int, err error) {
+	if cmd.ReadingID == "" {
+		return 0, 0, core.New(core.ValidationError, "reading id is required")
+	}
+
+	u, err := h.Recalculator.Recalculate(cmd.UserID, func(tx ports.Repositories) error {
+		rd, err := loadOwnedReading(tx.Readings, cmd.UserID, cmd.ReadingID)
+		if err != nil {
+			return err
+		}
+		if err := tx.Readings.Delete(rd.ID); err != nil {
+			return core.New(core.ServerError, "failed to delete reading")
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return u.StreakCurrentDays, u.TotalMinutes, nil
+}
+
+// loadOwnedReading fetches a reading log and makes sure it belongs to userID.
+// Someone else's log is reported as not found so IDs can't be probed.
+func loadOwnedReading(repo ports.ReadingRepository, userID, readingID string) (*reading.Reading, error) {
+	rd, err := repo.Get(readingID)
+	if err != nil || rd.UserID != userID {
+		return nil, core.New(core.NotFoundError, "reading not found")
+	}
+	return rd, nil
+}
@@ -0,0 +1,276 @@
+package reading
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/competition"
+	"github.com/bakhtybayevn/powerbook/internal/domain/reading"
+	"github.com/bakhtybayevn/powerbook/internal/domain/user"
+)
+
+func TestEditReadingRecalculates(t *testing.T) {
+	capped := competition.Rules{Type: competition.ScoringCappedMinutes, PointsPerMinute: 1, DailyMinuteCap: 60}
+
+	// every case starts from 50 min two days ago and 50 + 30 min yesterday:
+	// 110 capped points over two days
+	type change func(t *testing.T, f *fixture, ids []string) error
+
+	update := func(i, minutes int, ts *time.Time) change {
+		return func(t *testing.T, f *fixture, ids []string) error {
+			_, _, err := NewUpdateReadingHandler(nil, NewStatsRecalculator(f.uow, f.lb)).Handle(UpdateReadingCommand{
+				UserID: f.user.ID, ReadingID: ids[i], Minutes: minutes, Timestamp: ts,
+			})
+			return err
+		}
+	}
+	remove := func(i int) change {
+		return func(t *testing.T, f *fixture, ids []string) error {
+			_, _, err := NewDeleteReadingHandler(NewStatsRecalculator(f.uow, f.lb)).Handle(DeleteReadingCommand{
+				UserID: f.user.ID, ReadingID: ids[i],
+			})
+			return err
+		}
+	}
+	threeDaysAgo := at(3, 9)
+
+	tests := []struct {
+		name         string
+		change       change
+		closeFirst   bool
+		wantErr      core.ErrorType
+		wantPoints   int
+		wantDaysRead int
+		wantMinutes  int
+		wantStreak   int
+	}{
+		{
+			name:         "shorter log frees room under the cap",
+			change:       update(1, 20, nil),
+			wantPoints:   100,
+			wantDaysRead: 2,
+			wantMinutes:  100,
+			wantStreak:   2,
+		},
+		{
+			name:         "log moved to another day",
+			change:       update(2, 30, &threeDaysAgo),
+			wantPoints:   130,
+			wantDaysRead: 3,
+			wantMinutes:  130,
+			wantStreak:   3,
+		},
+		{
+			name:         "deleted log takes its day with it",
+			change:       remove(0),
+			wantPoints:   60,
+			wantDaysRead: 1,
+			wantMinutes:  80,
+			wantStreak:   1,
+		},
+		{
+			name:         "update over the daily limit is refused",
+			change:       update(1, 1420, nil),
+			wantErr:      core.ValidationError,
+			wantPoints:   110,
+			wantDaysRead: 2,
+			wantMinutes:  130,
+			wantStreak:   2,
+		},
+		{
+			name: "someone else's log is not found",
+			change: func(t *testing.T, f *fixture, _ []string) error {
+				other := reading.NewReading("someone-else", 10, "paper", at(1, 9))
+				if err := f.repos.Readings.Save(other); err != nil {
+					t.Fatalf("save reading: %v", err)
+				}
+				return remove(0)(t, f, []string{other.ID})
+			},
+			wantErr:      core.NotFoundError,
+			wantPoints:   110,
+			wantDaysRead: 2,
+			wantMinutes:  130,
+			wantStreak:   2,
+		},
+		{
+			name:         "closed competitions keep their final standings",
+			change:       remove(0),
+			closeFirst:   true,
+			wantPoints:   110,
+			wantDaysRead: 2,
+			wantMinutes:  80,
+			wantStreak:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture(t)
+			cmp := f.competition(t, capped, 10)
+
+			for _, l := range []logAt{{2, 9, 50}, {1, 9, 50}, {1, 20, 30}} {
+				f.log(t, at(l.daysAgo, l.hour), l.minutes)
+			}
+			ids := f.readingIDs(t)
+
+			if tt.closeFirst {
+				if _, err := f.repos.Competitions.TransitionStatus(cmp.ID, competition.StatusOpen, competition.StatusClosed); err != nil {
+					t.Fatalf("close competition: %v", err)
+				}
+			}
+
+			err := tt.change(t, f, ids)
+			if tt.wantErr != "" {
+				if !core.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %s", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("change: %v", err)
+			}
+
+			p := f.participant(t, cmp.ID)
+			if p.Points != tt.wantPoints {
+				t.Errorf("points = %d, want %d", p.Points, tt.wantPoints)
+			}
+			if p.DaysRead != tt.wantDaysRead {
+				t.Errorf("days read = %d, want %d", p.DaysRead, tt.wantDaysRead)
+			}
+			if !tt.closeFirst {
+				if got := f.score(t, cmp.ID); got != float64(tt.wantPoints) {
+					t.Errorf("leaderboard score = %v, want %d", got, tt.wantPoints)
+				}
+			}
+
+			u, err := f.repos.Users.Get(f.user.ID)
+			if err != nil {
+				t.Fatalf("get user: %v", err)
+			}
+			if u.TotalMinutes != tt.wantMinutes {
+				t.Errorf("total minutes = %d, want %d", u.TotalMinutes, tt.wantMinutes)
+			}
+			if u.StreakCurrentDays != tt.wantStreak {
+				t.Errorf("streak = %d, want %d", u.StreakCurrentDays, tt.wantStreak)
+			}
+		})
+	}
+}
+
+func TestRecalculateMatchesLiveStreaks(t *testing.T) {
+	f := newFixture(t)
+	cmp := f.competition(t, competition.Rules{Type: competition.ScoringLongestStreak, PointsPerUnit: 10}, 10)
+
+	// the gap two days ago is filled in last
+	for _, l := range []logAt{{4, 9, 20}, {3, 9, 20}, {1, 9, 20}, {2, 9, 20}} {
+		f.log(t, at(l.daysAgo, l.hour), l.minutes)
+	}
+	live := *f.participant(t, cmp.ID)
+	if live.StreakLongest != 4 {
+		t.Fatalf("live longest streak = %d, want 4", live.StreakLongest)
+	}
+
+	// an unrelated edit rebuilds the stats from the logs
+	ids := f.readingIDs(t)
+	if _, _, err := NewUpdateReadingHandler(nil, NewStatsRecalculator(f.uow, f.lb)).Handle(UpdateReadingCommand{
+		UserID: f.user.ID, ReadingID: ids[0], Minutes: 25,
+	}); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+
+	rebuilt := f.participant(t, cmp.ID)
+	if rebuilt.Points != live.Points || rebuilt.StreakCurrent != live.StreakCurrent || rebuilt.StreakLongest != live.StreakLongest {
+		t.Errorf("rebuild changed the score: points %d -> %d, streak %d/%d -> %d/%d",
+			live.Points, rebuilt.Points, live.StreakCurrent, live.StreakLongest, rebuilt.StreakCurrent, rebuilt.StreakLongest)
+	}
+}
+
+func TestRecalculateKeepsFrozenDays(t *testing.T) {
+	f := newFixture(t)
+	f.user.StreakFreezes = 1
+	if err := f.repos.Users.Save(f.user); err != nil {
+		t.Fatalf("save user: %v", err)
+	}
+
+	// four days ago, (missed, frozen), two days ago, yesterday
+	f.log(t, at(4, 9), 30)
+	if res := f.log(t, at(2, 9), 30); !res.StreakFreezeUsed {
+		t.Fatalf("the missed day was not frozen")
+	}
+	f.log(t, at(1, 9), 30)
+
+	ids := f.readingIDs(t)
+	newStreak, total, err := NewDeleteReadingHandler(NewStatsRecalculator(f.uow, f.lb)).Handle(DeleteReadingCommand{
+		UserID: f.user.ID, ReadingID: ids[2],
+	})
+	if err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if newStreak != 2 {
+		t.Errorf("streak = %d, want 2: the frozen day still bridges the gap", newStreak)
+	}
+	if total != 60 {
+		t.Errorf("total minutes = %d, want 60", total)
+	}
+
+	u, err := f.repos.Users.Get(f.user.ID)
+	if err != nil {
+		t.Fatalf("get user: %v", err)
+	}
+	if u.StreakFreezes != 0 {
+		t.Errorf("freezes = %d, want 0: a spent freeze is not refunded", u.StreakFreezes)
+	}
+}
+
+// readingIDs lists the reader's logs oldest first.
+func (f *fixture) readingIDs(t *testing.T) []string {
+	t.Helper()
+
+	logs, err := f.repos.Readings.ListByUser(f.user.ID)
+	if err != nil {
+		t.Fatalf("list readings: %v", err)
+	}
+	sort.Slice(logs, func(i, j int) bool { return logs[i].Timestamp.Before(logs[j].Timestamp) })
+
+	ids := make([]string, len(logs))
+	for i, l := range logs {
+		ids[i] = l.ID
+	}
+	return ids
+}
+
+func TestRelogMilestoneDayEarnsNoSecondFreeze(t *testing.T) {
+	f := newFixture(t)
+	days := user.StreakFreezeEarnEvery
+	for d := days; d >= 1; d-- {
+		f.log(t, at(d, 9), 30)
+	}
+	if got := f.freezes(t); got != 1 {
+		t.Fatalf("freezes = %d after a %d-day streak, want 1", got, days)
+	}
+
+	for i := 0; i < 3; i++ {
+		ids := f.readingIDs(t)
+		if _, _, err := NewDeleteReadingHandler(NewStatsRecalculator(f.uow, f.lb)).Handle(DeleteReadingCommand{
+			UserID: f.user.ID, ReadingID: ids[len(ids)-1],
+		}); err != nil {
+			t.Fatalf("delete: %v", err)
+		}
+		if res := f.log(t, at(1, 9), 30); res.StreakFreezeEarned || res.NewStreak != days {
+			t.Errorf("re-logged milestone day: streak %d, earned %v; want %d and no freeze", res.NewStreak, res.StreakFreezeEarned, days)
+		}
+	}
+	if got := f.freezes(t); got != 1 {
+		t.Errorf("freezes = %d after re-logging the milestone day, want 1", got)
+	}
+}
+
+func (f *fixture) freezes(t *testing.T) int {
+	t.Helper()
+
+	u, err := f.repos.Users.Get(f.user.ID)
+	if err != nil {
+		t.Fatalf("get user: %v", err)
+	}
+	return u.StreakFreezes
+}
@@ -5,8 +5,15 @@ import (
 	"fmt"
 	"time"
 
+	appAchievement "github.com/bakhtybayevn/powerbook/internal/application/achievement"
 	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/achievement"
+	"github.com/bakhtybayevn/powerbook/internal/domain/calendar"
+	"github.com/bakhtybayevn/powerbook/internal/domain/competition"
+	"github.com/bakhtybayevn/powerbook/internal/domain/notification"
 	"github.com/bakhtybayevn/powerbook/internal/domain/reading"
+	"github.com/bakhtybayevn/powerbook/internal/domain/user"
+	"github.com/bakhtybayevn/powerbook/internal/domain/xp"
 	"github.com/bakhtybayevn/powerbook/internal/ports"
 )
 
@@ -15,102 +22,279 @@ type LogReadingCommand struct {
 	Minutes   int
 	Source    string
 	Timestamp time.Time
+
+	// optional: the catalog book read during this session
+	BookID       string
+	PagesRead    int
+	FinishedBook bool
+}
+
+type LogReadingResult struct {
+	NewStreak          int
+	TotalMinutes       int
+	StreakFreezes      int  // freezes left after this log
+	StreakFreezeUsed   bool // a freeze covered a missed day
+	StreakFreezeEarned bool // this log reached a streak milestone
+
+	Achievements []achievement.Definition // unlocked by this log
+	LevelUps     []*xp.LevelUp            // reached through achievement XP
 }
 
 type LogReadingHandler struct {
-	UserRepo        ports.UserRepository
-	ReadingRepo     ports.ReadingRepository
-	CompetitionRepo ports.CompetitionRepository
-	Leaderboard     ports.LeaderboardPort // NEW
+	UoW         ports.UnitOfWork // reading, user, freeze events and participants commit together
+	BookRepo    ports.BookRepository
+	Leaderboard ports.LeaderboardPort
+	Notifier    ports.Notifier // optional: tells readers they were overtaken
 }
 
 func NewLogReadingHandler(
-	userRepo ports.UserRepository,
-	readingRepo ports.ReadingRepository,
-	competitionRepo ports.CompetitionRepository,
+	uow ports.UnitOfWork,
+	bookRepo ports.BookRepository,
 	leaderboard ports.LeaderboardPort,
+	notifier ports.Notifier,
 ) *LogReadingHandler {
 	return &LogReadingHandler{
-		UserRepo:        userRepo,
-		ReadingRepo:     readingRepo,
-		CompetitionRepo: competitionRepo,
-		Leaderboard:     leaderboard,
+		UoW:         uow,
+		BookRepo:    bookRepo,
+		Leaderboard: leaderboard,
+		Notifier:    notifier,
 	}
 }
 
-func (h *LogReadingHandler) Handle(cmd LogReadingCommand) (newStreak int, totalMinutes int, err error) {
+// scoreUpdate is a leaderboard change applied once the transaction commits.
+type scoreUpdate struct {
+	competitionID   string
+	competitionName string
+	teamID          string
+	points          int
+}
+
+func (h *LogReadingHandler) Handle(cmd LogReadingCommand) (*LogReadingResult, error) {
 	// validation
 	if cmd.Minutes <= 0 {
-		return 0, 0, core.New(core.ValidationError, "minutes must be > 0")
+		return nil, core.New(core.ValidationError, "minutes must be > 0")
 	}
 	if cmd.Source == "" {
 		cmd.Source = "unknown"
 	}
 	if cmd.Minutes > 1440 {
-		return 0, 0, core.New(core.ValidationError, "minutes cannot exceed 1440 (24 hours)")
+		return nil, core.New(core.ValidationError, "minutes cannot exceed 1440 (24 hours)")
+	}
+
+	if err := validateBook(h.BookRepo, cmd.BookID, cmd.PagesRead, cmd.FinishedBook); err != nil {
+		return nil, err
 	}
 
 	now := time.Now().UTC()
 	if cmd.Timestamp.After(now) {
-		return 0, 0, core.New(core.ValidationError, "timestamp cannot be in the future")
+		return nil, core.New(core.ValidationError, "timestamp cannot be in the future")
 	}
 
-	// Check daily cap: max 1440 minutes per day
-	dayStart := time.Date(cmd.Timestamp.Year(), cmd.Timestamp.Month(), cmd.Timestamp.Day(), 0, 0, 0, 0, time.UTC)
-	dayEnd := dayStart.Add(24 * time.Hour)
-	dayLogs, _ := h.ReadingRepo.ListByDateRange(cmd.UserID, dayStart, dayEnd)
-	dayTotal := 0
-	for _, dl := range dayLogs {
-		dayTotal += dl.Minutes
-	}
-	if dayTotal+cmd.Minutes > 1440 {
-		remaining := 1440 - dayTotal
-		if remaining <= 0 {
-			return 0, 0, core.New(core.ValidationError, "daily reading limit reached (1440 min/day)")
+	var (
+		result  *LogReadingResult
+		updates []scoreUpdate
+		reader  string // display name, for overtake notifications
+	)
+	err := h.UoW.Do(func(tx ports.Repositories) error {
+		// load user
+		u, err := tx.Users.Get(cmd.UserID)
+		if err != nil {
+			return core.New(core.NotFoundError, "user not found")
 		}
-		return 0, 0, core.New(core.ValidationError, fmt.Sprintf("would exceed daily limit; you can log %d more minutes today", remaining))
-	}
 
-	// load user
-	u, err := h.UserRepo.Get(cmd.UserID)
-	if err != nil {
-		return 0, 0, core.New(core.NotFoundError, "user not found")
-	}
+		// Check daily cap: max 1440 minutes per local calendar day
+		if err := checkDailyCap(tx.Readings, cmd.UserID, cmd.Timestamp, u.Location(), cmd.Minutes, ""); err != nil {
+			return err
+		}
 
-	// domain logic - update user streak
-	newStreak, totalMinutes = u.LogReading(cmd.Minutes, cmd.Timestamp)
+		// the earlier logs, for the competitions' daily caps and streaks
+		earlier, err := tx.Readings.ListByUser(cmd.UserID)
+		if err != nil {
+			return core.New(core.ServerError, "failed to load reading logs")
+		}
 
-	// persist reading log
-	rd := reading.NewReading(cmd.UserID, cmd.Minutes, cmd.Source, cmd.Timestamp.UTC())
-	if err := h.ReadingRepo.Save(rd); err != nil {
-		return 0, 0, core.New(core.ServerError, "failed to save reading")
-	}
+		// domain logic - update user streak (may spend or earn a streak freeze)
+		freezesBefore := u.StreakFreezes
+		newStreak, totalMinutes := u.LogReading(cmd.Minutes, cmd.Timestamp)
 
-	// save updated user
-	if err := h.UserRepo.Save(u); err != nil {
-		return 0, 0, core.New(core.ServerError, "failed to update user")
-	}
+		// persist reading log
+		rd := reading.NewReading(cmd.UserID, cmd.Minutes, cmd.Source, cmd.Timestamp.UTC()).
+			WithBook(cmd.BookID, cmd.PagesRead, cmd.FinishedBook)
+		if err := tx.Readings.Save(rd); err != nil {
+			return core.New(core.ServerError, "failed to save reading")
+		}
+
+		// save updated user
+		if err := tx.Users.Save(u); err != nil {
+			return core.New(core.ServerError, "failed to update user")
+		}
+
+		freezeUsed := false
+		for _, e := range u.TakeFreezeEvents() {
+			if e.Kind == user.FreezeUsed {
+				freezeUsed = true
+			}
+			if err := tx.Freezes.SaveEvent(e); err != nil {
+				return err
+			}
+		}
 
-	// === AWARD POINTS TO COMPETITIONS ===
-	activeComps, err := h.CompetitionRepo.FindActive(cmd.Timestamp)
-	if err == nil {
+		// === AWARD POINTS TO COMPETITIONS ===
+		activeComps, err := tx.Competitions.FindActive(cmd.Timestamp)
+		if err != nil {
+			return err
+		}
 		for _, cmp := range activeComps {
 			participant, ok := cmp.Participants[cmd.UserID]
-			if !ok {
+			if !ok || !cmp.Counts(participant, cmd.Timestamp, u.Location()) {
 				continue
 			}
 
-			// update in competition object
-			participant.AddReading(cmd.Minutes, cmd.Timestamp, cmp.Rules)
-			_ = h.CompetitionRepo.Save(cmp)
+			// update in competition object; the rules' scoring strategy decides the points
+			dayMinutes, readDays := countedHistory(earlier, cmp, participant, cmd.Timestamp, u.Location())
+			points := participant.AddReading(sessionOf(rd), dayMinutes, readDays, cmp.Rules, u.Location())
+			if err := tx.Competitions.SaveParticipant(cmp.ID, participant); err != nil {
+				return err
+			}
+			updates = append(updates, scoreUpdate{
+				competitionID:   cmp.ID,
+				competitionName: cmp.Name,
+				teamID:          participant.TeamID,
+				points:          points,
+			})
+		}
 
-			// compute points
-			points := float64(cmp.Rules.PointsPerMinute * cmd.Minutes)
+		earned, levelUps, err := appAchievement.Evaluate(tx, cmd.UserID)
+		if err != nil {
+			return err
+		}
 
-			// push to Redis leaderboard (best effort)
-			_, _ = h.Leaderboard.AddScore(context.Background(), cmp.ID, cmd.UserID, points)
+		reader = u.DisplayName
+		result = &LogReadingResult{
+			NewStreak:          newStreak,
+			TotalMinutes:       totalMinutes,
+			StreakFreezes:      u.StreakFreezes,
+			StreakFreezeUsed:   freezeUsed,
+			StreakFreezeEarned: !freezeUsed && u.StreakFreezes > freezesBefore,
+			Achievements:       earned,
+			LevelUps:           levelUps,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// push to Redis leaderboard only after commit (best effort; the drift
+	// check repairs anything lost here)
+	for _, up := range updates {
+		score, err := h.Leaderboard.AddScore(context.Background(), up.competitionID, cmd.UserID, float64(up.points))
+		if up.teamID != "" {
+			_, _ = h.Leaderboard.AddTeamScore(context.Background(), up.competitionID, up.teamID, float64(up.points))
+		}
+		if err == nil && up.points > 0 && h.Notifier != nil {
+			h.notifyOvertaken(cmd.UserID, reader, up, score)
 		}
 	}
 
-	return newStreak, totalMinutes, nil
+	return result, nil
+}
+
+// notifyOvertaken tells everyone the reader passed on the board: those who
+// were strictly ahead before this log and are strictly behind now.
+func (h *LogReadingHandler) notifyOvertaken(userID, reader string, up scoreUpdate, score float64) {
+	before := score - float64(up.points)
+	entries, err := h.Leaderboard.GetTop(context.Background(), up.competitionID, 0)
+	if err != nil {
+		return
+	}
+
+	var ns []*notification.Notification
+	for _, e := range entries {
+		if e.UserID == userID || e.Score <= before || e.Score >= score {
+			continue
+		}
+		ns = append(ns, notification.New(e.UserID, notification.KindOvertaken,
+			"You were overtaken in "+up.competitionName,
+			fmt.Sprintf("%s passed you with %.0f pts. You have %.0f.", reader, score, e.Score)).
+			About(up.competitionID))
+	}
+	if len(ns) > 0 {
+		h.Notifier.Notify(ns...)
+	}
+}
+
+// sessionOf extracts what competition scoring needs from a reading log.
+func sessionOf(rd *reading.Reading) competition.ReadingSession {
+	return competition.ReadingSession{
+		Minutes:      rd.Minutes,
+		PagesRead:    rd.PagesRead,
+		FinishedBook: rd.FinishedBook,
+		Timestamp:    rd.Timestamp,
+	}
+}
+
+// countedHistory goes through the logs that count towards cmp for p: the
+// minutes on the same day as ts, and every day with at least one log.
+func countedHistory(logs []reading.Reading, cmp *competition.Competition, p *competition.Participant, ts time.Time, loc *time.Location) (dayMinutes int, readDays []time.Time) {
+	day := calendar.Day(ts, loc)
+	seen := map[time.Time]bool{}
+	for _, l := range logs {
+		if !cmp.Counts(p, l.Timestamp, loc) {
+			continue
+		}
+		d := calendar.Day(l.Timestamp, loc)
+		if d.Equal(day) {
+			dayMinutes += l.Minutes
+		}
+		if !seen[d] {
+			seen[d] = true
+			readDays = append(readDays, d)
+		}
+	}
+	return dayMinutes, readDays
+}
+
+// checkDailyCap rejects a reading that would push the user's total for that
+// local calendar day past 1440 minutes. excludeID skips an existing log (used when editing it).
+func checkDailyCap(repo ports.ReadingRepository, userID string, ts time.Time, loc *time.Location, minutes int, excludeID string) error {
+	dayStart, dayEnd := calendar.Bounds(ts, loc)
+	dayLogs, _ := repo.ListByDateRange(userID, dayStart, dayEnd)
+	dayTotal := 0
+	for _, dl := range dayLogs {
+		if dl.ID == excludeID {
+			continue
+		}
+		dayTotal += dl.Minutes
+	}
+	if dayTotal+minutes > 1440 {
+		remaining := 1440 - dayTotal
+		if remaining <= 0 {
+			return core.New(core.ValidationError, "daily reading limit reached (1440 min/day)")
+		}
+		return core.New(core.ValidationError, fmt.Sprintf("would exceed daily limit; you can log %d more minutes today", remaining))
+	}
+	return nil
+}
+
+// validateBook checks that an optional book reference exists and that
+// pagesRead fits inside it. Finishing a book requires naming it.
+func validateBook(repo ports.BookRepository, bookID string, pagesRead int, finished bool) error {
+	if pagesRead < 0 {
+		return core.New(core.ValidationError, "pages_read cannot be negative")
+	}
+	if bookID == "" {
+		if finished {
+			return core.New(core.ValidationError, "book_id is required to mark a book finished")
+		}
+		return nil
+	}
+	b, err := repo.Get(bookID)
+	if err != nil {
+		return core.New(core.NotFoundError, "book not found")
+	}
+	if b.PageCount > 0 && pagesRead > b.PageCount {
+		return core.New(core.ValidationError, fmt.Sprintf("pages_read cannot exceed the book's %d pages", b.PageCount))
+	}
+	return nil
 }
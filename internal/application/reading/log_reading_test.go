@@ -0,0 +1,332 @@
+package reading
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bakhtybayevn/powerbook/internal/adapters/memory"
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/calendar"
+	"github.com/bakhtybayevn/powerbook/internal/domain/competition"
+	"github.com/bakhtybayevn/powerbook/internal/domain/user"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// fixture is one reader on the in-memory adapters.
+type fixture struct {
+	repos ports.Repositories
+	uow   ports.UnitOfWork
+	lb    *memory.Leaderboard
+	user  *user.User
+}
+
+func newFixture(t *testing.T) *fixture {
+	t.Helper()
+
+	repos := ports.Repositories{
+		Users:        memory.NewUserRepo(),
+		Readings:     memory.NewReadingRepo(),
+		Competitions: memory.NewCompetitionRepo(),
+		Freezes:      memory.NewStreakFreezeRepo(),
+		XPLedger:     memory.NewXPLedgerRepo(),
+		Achievements: memory.NewAchievementRepo(),
+		XPPolicies:   memory.NewXPPolicyRepo(),
+		LevelUps:     memory.NewLevelUpRepo(),
+		Series:       memory.NewSeriesRepo(),
+	}
+	u := user.NewUser("reader@example.com", "Reader", "secret123")
+	if err := repos.Users.Save(u); err != nil {
+		t.Fatalf("save user: %v", err)
+	}
+	return &fixture{repos: repos, uow: memory.NewUnitOfWork(repos), lb: memory.NewLeaderboard(), user: u}
+}
+
+// at is hour o'clock UTC, daysAgo days before today. Tests only log on past
+// days so nothing lands in the future, whatever the time of day.
+func at(daysAgo, hour int) time.Time {
+	today := calendar.Day(time.Now().UTC(), time.UTC)
+	return today.AddDate(0, 0, -daysAgo).Add(time.Duration(hour) * time.Hour)
+}
+
+// competition opens a competition that started a month ago, with the reader
+// joined daysAgo days ago.
+func (f *fixture) competition(t *testing.T, rules competition.Rules, joinedDaysAgo int) *competition.Competition {
+	t.Helper()
+
+	cmp, err := competition.NewCompetition("Sprint", at(30, 0), time.Now().UTC().AddDate(0, 0, 7), rules)
+	if err != nil {
+		t.Fatalf("new competition: %v", err)
+	}
+	if err := f.repos.Competitions.Create(cmp); err != nil {
+		t.Fatalf("create competition: %v", err)
+	}
+	p := competition.NewParticipant(f.user.ID)
+	p.JoinedAt = at(joinedDaysAgo, 0)
+	if err := f.repos.Competitions.SaveParticipant(cmp.ID, p); err != nil {
+		t.Fatalf("save participant: %v", err)
+	}
+	return cmp
+}
+
+func (f *fixture) participant(t *testing.T, competitionID string) *competition.Participant {
+	t.Helper()
+
+	cmp, err := f.repos.Competitions.Get(competitionID)
+	if err != nil {
+		t.Fatalf("get competition: %v", err)
+	}
+	p, ok := cmp.Participants[f.user.ID]
+	if !ok {
+		t.Fatalf("reader is not a participant")
+	}
+	return p
+}
+
+// score is the reader's leaderboard score in the competition.
+func (f *fixture) score(t *testing.T, competitionID string) float64 {
+	t.Helper()
+
+	_, score, err := f.lb.GetRank(context.Background(), competitionID, f.user.ID)
+	if err != nil {
+		t.Fatalf("get rank: %v", err)
+	}
+	return score
+}
+
+func (f *fixture) logReading() *LogReadingHandler {
+	return NewLogReadingHandler(f.uow, memory.NewBookRepo(), f.lb, nil)
+}
+
+func (f *fixture) log(t *testing.T, ts time.Time, minutes int) *LogReadingResult {
+	t.Helper()
+
+	res, err := f.logReading().Handle(LogReadingCommand{UserID: f.user.ID, Minutes: minutes, Source: "paper", Timestamp: ts})
+	if err != nil {
+		t.Fatalf("log %d min at %s: %v", minutes, ts, err)
+	}
+	return res
+}
+
+type logAt struct {
+	daysAgo, hour, minutes int
+}
+
+func TestLogReadingScoresCompetitions(t *testing.T) {
+	capped := competition.Rules{Type: competition.ScoringCappedMinutes, PointsPerMinute: 1, DailyMinuteCap: 60}
+
+	tests := []struct {
+		name          string
+		rules         competition.Rules
+		joinedDaysAgo int
+		logs          []logAt
+		wantPoints    int
+		wantDaysRead  int
+		wantCapped    int
+	}{
+		{
+			name:          "minutes",
+			rules:         competition.Rules{PointsPerMinute: 2},
+			joinedDaysAgo: 10,
+			logs:          []logAt{{1, 9, 30}, {1, 20, 45}},
+			wantPoints:    150,
+			wantDaysRead:  1,
+		},
+		{
+			name:          "daily cap",
+			rules:         capped,
+			joinedDaysAgo: 10,
+			logs:          []logAt{{1, 9, 40}, {1, 12, 40}, {1, 20, 30}},
+			wantPoints:    60,
+			wantDaysRead:  1,
+			wantCapped:    60,
+		},
+		{
+			name:          "backdated sessions are capped against their own day",
+			rules:         capped,
+			joinedDaysAgo: 10,
+			logs:          []logAt{{1, 9, 50}, {2, 9, 50}, {2, 20, 50}},
+			wantPoints:    110,
+			wantDaysRead:  2,
+			wantCapped:    110,
+		},
+		{
+			name:          "backdated day counts as a day read",
+			rules:         competition.Rules{Type: competition.ScoringDaysRead, PointsPerUnit: 10},
+			joinedDaysAgo: 10,
+			logs:          []logAt{{1, 9, 30}, {3, 9, 20}, {3, 20, 20}},
+			wantPoints:    20,
+			wantDaysRead:  2,
+		},
+		{
+			name:          "backdated day joins the streaks on either side",
+			rules:         competition.Rules{Type: competition.ScoringLongestStreak, PointsPerUnit: 10},
+			joinedDaysAgo: 10,
+			logs:          []logAt{{5, 9, 20}, {4, 9, 20}, {2, 9, 20}, {1, 9, 20}, {3, 9, 20}},
+			wantPoints:    50,
+			wantDaysRead:  5,
+		},
+		{
+			name:          "sessions before joining do not count",
+			rules:         competition.Rules{PointsPerMinute: 1},
+			joinedDaysAgo: 2,
+			logs:          []logAt{{5, 9, 30}, {1, 9, 30}},
+			wantPoints:    30,
+			wantDaysRead:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture(t)
+			cmp := f.competition(t, tt.rules, tt.joinedDaysAgo)
+
+			for _, l := range tt.logs {
+				f.log(t, at(l.daysAgo, l.hour), l.minutes)
+			}
+
+			p := f.participant(t, cmp.ID)
+			if p.Points != tt.wantPoints {
+				t.Errorf("points = %d, want %d", p.Points, tt.wantPoints)
+			}
+			if p.DaysRead != tt.wantDaysRead {
+				t.Errorf("days read = %d, want %d", p.DaysRead, tt.wantDaysRead)
+			}
+			if p.CappedMinutes != tt.wantCapped {
+				t.Errorf("capped minutes = %d, want %d", p.CappedMinutes, tt.wantCapped)
+			}
+			if got := f.score(t, cmp.ID); got != float64(tt.wantPoints) {
+				t.Errorf("leaderboard score = %v, want %d", got, tt.wantPoints)
+			}
+		})
+	}
+}
+
+func TestLogReadingStreakFreezes(t *testing.T) {
+	tests := []struct {
+		name         string
+		streak       int
+		lastDaysAgo  int
+		freezes      int
+		wantStreak   int
+		wantUsed     bool
+		wantEarned   bool
+		wantFreezes  int
+		wantFrozeDay bool
+	}{
+		{
+			name:        "next day extends the streak",
+			streak:      3,
+			lastDaysAgo: 2,
+			wantStreak:  4,
+		},
+		{
+			name:         "a freeze covers one missed day",
+			streak:       3,
+			lastDaysAgo:  3,
+			freezes:      1,
+			wantStreak:   4,
+			wantUsed:     true,
+			wantFrozeDay: true,
+		},
+		{
+			name:        "a missed day without a freeze resets the streak",
+			streak:      3,
+			lastDaysAgo: 3,
+			wantStreak:  1,
+		},
+		{
+			name:        "two missed days reset the streak and keep the freeze",
+			streak:      3,
+			lastDaysAgo: 4,
+			freezes:     1,
+			wantStreak:  1,
+			wantFreezes: 1,
+		},
+		{
+			name:        "a streak milestone earns a freeze",
+			streak:      user.StreakFreezeEarnEvery - 1,
+			lastDaysAgo: 2,
+			wantStreak:  user.StreakFreezeEarnEvery,
+			wantEarned:  true,
+			wantFreezes: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture(t)
+			last := calendar.Day(at(tt.lastDaysAgo, 0), time.UTC)
+			f.user.StreakCurrentDays = tt.streak
+			f.user.StreakLastDate = &last
+			f.user.StreakFreezes = tt.freezes
+			if err := f.repos.Users.Save(f.user); err != nil {
+				t.Fatalf("save user: %v", err)
+			}
+
+			res := f.log(t, at(1, 9), 30)
+			if res.NewStreak != tt.wantStreak {
+				t.Errorf("streak = %d, want %d", res.NewStreak, tt.wantStreak)
+			}
+			if res.StreakFreezeUsed != tt.wantUsed {
+				t.Errorf("freeze used = %v, want %v", res.StreakFreezeUsed, tt.wantUsed)
+			}
+			if res.StreakFreezeEarned != tt.wantEarned {
+				t.Errorf("freeze earned = %v, want %v", res.StreakFreezeEarned, tt.wantEarned)
+			}
+			if res.StreakFreezes != tt.wantFreezes {
+				t.Errorf("freezes left = %d, want %d", res.StreakFreezes, tt.wantFreezes)
+			}
+
+			events, err := f.repos.Freezes.ListByUser(f.user.ID)
+			if err != nil {
+				t.Fatalf("list freeze events: %v", err)
+			}
+			frozeDay := false
+			for _, e := range events {
+				if e.Kind == user.FreezeUsed && e.Day != nil && calendar.Day(*e.Day, time.UTC).Equal(calendar.Day(at(2, 0), time.UTC)) {
+					frozeDay = true
+				}
+			}
+			if frozeDay != tt.wantFrozeDay {
+				t.Errorf("missed day recorded as frozen = %v, want %v (events: %+v)", frozeDay, tt.wantFrozeDay, events)
+			}
+		})
+	}
+}
+
+func TestLogReadingValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		earlier int // minutes already logged that day
+		minutes int
+		ts      time.Time
+	}{
+		{name: "zero minutes", minutes: 0, ts: at(1, 9)},
+		{name: "more than a day", minutes: 1441, ts: at(1, 9)},
+		{name: "in the future", minutes: 30, ts: time.Now().UTC().Add(time.Hour)},
+		{name: "over the daily limit", earlier: 1420, minutes: 30, ts: at(1, 20)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture(t)
+			if tt.earlier > 0 {
+				f.log(t, at(1, 1), tt.earlier)
+			}
+
+			_, err := f.logReading().Handle(LogReadingCommand{UserID: f.user.ID, Minutes: tt.minutes, Timestamp: tt.ts})
+			if !core.Is(err, core.ValidationError) {
+				t.Fatalf("err = %v, want a validation error", err)
+			}
+
+			u, err := f.repos.Users.Get(f.user.ID)
+			if err != nil {
+				t.Fatalf("get user: %v", err)
+			}
+			if u.TotalMinutes != tt.earlier {
+				t.Errorf("total minutes = %d, want %d", u.TotalMinutes, tt.earlier)
+			}
+		})
+	}
+}
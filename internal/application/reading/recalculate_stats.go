@@ -0,0 +1,144 @@
+package reading
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/calendar"
+	"github.com/bakhtybayevn/powerbook/internal/domain/competition"
+	"github.com/bakhtybayevn/powerbook/internal/domain/user"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// StatsRecalculator rebuilds everything derived from a user's reading logs:
+// user totals and streak, participant stats in open competitions, and the
+// matching leaderboard scores. Used after a log is edited or deleted, where
+// incremental deltas can't be undone reliably.
+type StatsRecalculator struct {
+	UoW         ports.UnitOfWork // the change and the rebuilt stats commit together
+	Leaderboard ports.LeaderboardPort
+}
+
+func NewStatsRecalculator(uow ports.UnitOfWork, leaderboard ports.LeaderboardPort) *StatsRecalculator {
+	return &StatsRecalculator{UoW: uow, Leaderboard: leaderboard}
+}
+
+// participantScore is a rebuilt score published once the transaction commits.
+type participantScore struct {
+	competitionID string
+	teamID        string
+	points        int
+	teamPoints    int
+}
+
+// Recalculate applies change to the user's reading logs and rebuilds the
+// stats from the result in the same unit of work.
+func (r *StatsRecalculator) Recalculate(userID string, change func(tx ports.Repositories) error) (*user.User, error) {
+	var (
+		u      *user.User
+		scores []participantScore
+	)
+	err := r.UoW.Do(func(tx ports.Repositories) error {
+		if err := change(tx); err != nil {
+			return err
+		}
+		var err error
+		u, scores, err = rebuild(tx, userID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// push to Redis leaderboard (best effort)
+	for _, sc := range scores {
+		_ = r.Leaderboard.SetScore(context.Background(), sc.competitionID, userID, float64(sc.points))
+		if sc.teamID != "" {
+			_ = r.Leaderboard.SetTeamScore(context.Background(), sc.competitionID, sc.teamID, float64(sc.teamPoints))
+		}
+	}
+
+	return u, nil
+}
+
+func rebuild(tx ports.Repositories, userID string) (*user.User, []participantScore, error) {
+	u, err := tx.Users.Get(userID)
+	if err != nil {
+		return nil, nil, core.New(core.NotFoundError, "user not found")
+	}
+
+	logs, err := tx.Readings.ListByUser(userID)
+	if err != nil {
+		return nil, nil, core.New(core.ServerError, "failed to load reading logs")
+	}
+
+	// replay oldest first so streaks are rebuilt in order
+	sort.Slice(logs, func(i, j int) bool {
+		return logs[i].Timestamp.Before(logs[j].Timestamp)
+	})
+
+	// days already bridged by a spent freeze stay bridged; the balance itself is untouched
+	frozenDays := map[time.Time]bool{}
+	if events, err := tx.Freezes.ListByUser(userID); err == nil {
+		for _, e := range events {
+			if e.Kind == user.FreezeUsed && e.Day != nil {
+				frozenDays[calendar.Day(*e.Day, time.UTC)] = true
+			}
+		}
+	}
+
+	u.ResetReadingStats()
+	for _, l := range logs {
+		u.ReplayReading(l.Minutes, l.Timestamp, frozenDays)
+	}
+
+	if err := tx.Users.Save(u); err != nil {
+		return nil, nil, core.New(core.ServerError, "failed to update user")
+	}
+
+	comps, err := tx.Competitions.FindByUser(userID)
+	if err != nil {
+		return nil, nil, core.New(core.ServerError, "failed to load competitions")
+	}
+
+	var scores []participantScore
+	for _, cmp := range comps {
+		// closed competitions keep their final standings
+		if cmp.Status != competition.StatusOpen {
+			continue
+		}
+		p, ok := cmp.Participants[userID]
+		if !ok {
+			continue
+		}
+
+		p.Reset()
+		dayMinutes := map[time.Time]int{}
+		var readDays []time.Time
+		for i := range logs {
+			if !cmp.Counts(p, logs[i].Timestamp, u.Location()) {
+				continue
+			}
+			day := calendar.Day(logs[i].Timestamp, u.Location())
+			p.AddReading(sessionOf(&logs[i]), dayMinutes[day], readDays, cmp.Rules, u.Location())
+			if dayMinutes[day] == 0 {
+				readDays = append(readDays, day)
+			}
+			dayMinutes[day] += logs[i].Minutes
+		}
+
+		if err := tx.Competitions.SaveParticipant(cmp.ID, p); err != nil {
+			return nil, nil, core.New(core.ServerError, "failed to update participant")
+		}
+		scores = append(scores, participantScore{
+			competitionID: cmp.ID,
+			teamID:        p.TeamID,
+			points:        p.Points,
+			teamPoints:    cmp.TeamPoints(p.TeamID),
+		})
+	}
+
+	return u, scores, nil
+}
@@ -0,0 +1,235 @@
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	appReading "github.com/bakhtybayevn/powerbook/internal/application/reading"
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/competition"
+	"github.com/bakhtybayevn/powerbook/internal/domain/user"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+const helpText = `PowerBook bot commands:
+/link CODE - connect your Telegram account (get the code in the app)
+/log MINUTES [SOURCE] - log reading, e.g. /log 30 kindle
+/rank - your place in your open competitions
+/unlink - disconnect your Telegram account`
+
+// Bot answers chat commands. Each command runs the same use cases as the
+// HTTP API on behalf of the user the sender's Telegram account is linked to.
+// Account commands only work in a private chat with the bot, so nobody else
+// in a group can log or read on a member's behalf.
+type Bot struct {
+	Client       ports.TelegramBot
+	Links        ports.TelegramLinkRepository
+	Users        ports.UserRepository
+	Competitions ports.CompetitionRepository
+	Leaderboard  ports.LeaderboardPort
+	LogReading   *appReading.LogReadingHandler
+}
+
+func NewBot(
+	client ports.TelegramBot,
+	links ports.TelegramLinkRepository,
+	users ports.UserRepository,
+	competitions ports.CompetitionRepository,
+	leaderboard ports.LeaderboardPort,
+	logReading *appReading.LogReadingHandler,
+) *Bot {
+	return &Bot{
+		Client:       client,
+		Links:        links,
+		Users:        users,
+		Competitions: competitions,
+		Leaderboard:  leaderboard,
+		LogReading:   logReading,
+	}
+}
+
+// Run long-polls for messages until ctx is cancelled.
+func (b *Bot) Run(ctx context.Context) {
+	var offset int64
+	for ctx.Err() == nil {
+		updates, err := b.Client.GetUpdates(ctx, offset, 50*time.Second)
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Printf("[Telegram] failed to get updates: %v", err)
+			}
+			select {
+			case <-ctx.Done():
+			case <-time.After(5 * time.Second):
+			}
+			continue
+		}
+		for _, up := range updates {
+			offset = up.UpdateID + 1
+			b.handleSafely(ctx, up)
+		}
+	}
+}
+
+// handleSafely keeps the poller alive when one message makes a handler panic.
+func (b *Bot) handleSafely(ctx context.Context, up ports.TelegramUpdate) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("[Telegram] panic handling update %d: %v", up.UpdateID, r)
+		}
+	}()
+	b.HandleUpdate(ctx, up)
+}
+
+// HandleUpdate answers one message.
+func (b *Bot) HandleUpdate(ctx context.Context, up ports.TelegramUpdate) {
+	if up.ChatID == 0 || up.Text == "" {
+		return
+	}
+
+	reply := b.reply(ctx, up)
+	if reply == "" {
+		return
+	}
+	if err := b.Client.SendMessage(ctx, up.ChatID, reply); err != nil {
+		log.Printf("[Telegram] failed to reply to chat %d: %v", up.ChatID, err)
+	}
+}
+
+func (b *Bot) reply(ctx context.Context, up ports.TelegramUpdate) string {
+	fields := strings.Fields(up.Text)
+	if len(fields) == 0 {
+		return "" // only whitespace
+	}
+	// "/log@PowerBookBot 30" is how commands look in group chats
+	command := strings.ToLower(strings.SplitN(fields[0], "@", 2)[0])
+	args := fields[1:]
+
+	if command == "/help" {
+		return helpText
+	}
+	if !up.Private || up.FromID == 0 {
+		if strings.HasPrefix(command, "/") {
+			return "Account commands only work in a private chat with me."
+		}
+		return "" // group chatter is not for us
+	}
+
+	if command == "/start" || command == "/link" {
+		if len(args) == 0 {
+			return helpText
+		}
+		return b.link(up, args[0])
+	}
+
+	userID, err := b.Links.UserID(up.FromID)
+	if err != nil {
+		return "Something went wrong, please try again later."
+	}
+	if userID == "" {
+		return "Your Telegram account is not linked yet. Open PowerBook, get a link code and send /link CODE."
+	}
+
+	switch command {
+	case "/log":
+		return b.logReading(userID, args)
+	case "/rank":
+		return b.rank(ctx, userID)
+	case "/unlink":
+		if err := b.Links.Unlink(userID); err != nil {
+			return "Something went wrong, please try again later."
+		}
+		return "Your Telegram account is no longer linked to PowerBook."
+	default:
+		return helpText
+	}
+}
+
+func (b *Bot) link(up ports.TelegramUpdate, code string) string {
+	c, err := b.Links.TakeCode(user.NormalizeTelegramLinkCode(code))
+	if err != nil || c.Expired(time.Now().UTC()) {
+		return "That code is invalid or has expired. Get a new one in the app."
+	}
+	if err := b.Links.Link(c.UserID, up.FromID); err != nil {
+		return "Something went wrong, please try again later."
+	}
+
+	u, err := b.Users.Get(c.UserID)
+	if err != nil {
+		return "Linked."
+	}
+	if up.Username != "" && u.TelegramHandle == "" {
+		u.TelegramHandle = "@" + up.Username
+		_ = b.Users.UpdateProfile(u)
+	}
+	return fmt.Sprintf("Linked to %s. Log reading with /log 30.", u.DisplayName)
+}
+
+func (b *Bot) logReading(userID string, args []string) string {
+	if len(args) == 0 {
+		return "Usage: /log MINUTES [SOURCE], e.g. /log 30 kindle"
+	}
+	minutes, err := strconv.Atoi(args[0])
+	if err != nil {
+		return "Minutes must be a number, e.g. /log 30 kindle"
+	}
+	source := "telegram"
+	if len(args) > 1 {
+		source = strings.Join(args[1:], " ")
+	}
+
+	res, err := b.LogReading.Handle(appReading.LogReadingCommand{
+		UserID:    userID,
+		Minutes:   minutes,
+		Source:    source,
+		Timestamp: time.Now().UTC(),
+	})
+	if err != nil {
+		if core.Is(err, core.ValidationError) {
+			return err.Error()
+		}
+		return "Could not log your reading, please try again later."
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Logged %d min. Streak: %d days, total: %d min.", minutes, res.NewStreak, res.TotalMinutes)
+	if res.StreakFreezeUsed {
+		sb.WriteString("\nA streak freeze covered the day you missed.")
+	}
+	if res.StreakFreezeEarned {
+		sb.WriteString("\nYou earned a streak freeze!")
+	}
+	for _, a := range res.Achievements {
+		fmt.Fprintf(&sb, "\nBadge unlocked: %s", a.Name)
+	}
+	for _, l := range res.LevelUps {
+		fmt.Fprintf(&sb, "\nLevel up: %d %s", l.Level, l.LevelName)
+	}
+	return sb.String()
+}
+
+func (b *Bot) rank(ctx context.Context, userID string) string {
+	comps, err := b.Competitions.FindByUser(userID)
+	if err != nil {
+		return "Could not load your competitions, please try again later."
+	}
+
+	var lines []string
+	for _, cmp := range comps {
+		if cmp.Status != competition.StatusOpen {
+			continue
+		}
+		rank, score, err := b.Leaderboard.GetRank(ctx, cmp.ID, userID)
+		if err != nil || rank < 0 {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("%s: #%d of %d, %.0f pts", cmp.Name, rank+1, len(cmp.Participants), score))
+	}
+	if len(lines) == 0 {
+		return "You are not ranked in any open competition."
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,416 @@
+package telegram_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bakhtybayevn/powerbook/internal/adapters/memory"
+	tgClient "github.com/bakhtybayevn/powerbook/internal/adapters/telegram"
+	appReading "github.com/bakhtybayevn/powerbook/internal/application/reading"
+	appTelegram "github.com/bakhtybayevn/powerbook/internal/application/telegram"
+	"github.com/bakhtybayevn/powerbook/internal/domain/competition"
+	"github.com/bakhtybayevn/powerbook/internal/domain/user"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// fakeAPI is a Bot API stand-in: it hands out queued messages on getUpdates
+// and records what the bot sends.
+type fakeAPI struct {
+	mu      sync.Mutex
+	pending []map[string]any
+	sent    []sentMessage
+	nextID  int64
+}
+
+type sentMessage struct {
+	ChatID int64  `json:"chat_id"`
+	Text   string `json:"text"`
+}
+
+func (f *fakeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var result any
+	switch {
+	case strings.HasSuffix(r.URL.Path, "/getUpdates"):
+		result = f.pending
+		f.pending = nil
+	case strings.HasSuffix(r.URL.Path, "/sendMessage"):
+		var m sentMessage
+		if err := json.NewDecoder(r.Body).Decode(&m); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		f.sent = append(f.sent, m)
+		result = map[string]any{}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(map[string]any{"ok": false, "description": "Not Found"})
+		return
+	}
+	_ = json.NewEncoder(w).Encode(map[string]any{"ok": true, "result": result})
+}
+
+// message is one incoming message: who sent it and in which chat.
+type message struct {
+	fromID   int64
+	chatID   int64
+	chatType string
+	text     string
+}
+
+func private(fromID int64, text string) message {
+	return message{fromID: fromID, chatID: fromID, chatType: "private", text: text}
+}
+
+func group(fromID int64, text string) message {
+	return message{fromID: fromID, chatID: -100, chatType: "supergroup", text: text}
+}
+
+type botEnv struct {
+	api    *fakeAPI
+	client *tgClient.Client
+	bot    *appTelegram.Bot
+	users  *memory.UserRepo
+	links  *memory.TelegramLinkRepo
+	comps  *memory.CompetitionRepo
+}
+
+func newBotEnv(t *testing.T) *botEnv {
+	t.Helper()
+
+	api := &fakeAPI{}
+	srv := httptest.NewServer(api)
+	t.Cleanup(srv.Close)
+
+	repos := ports.Repositories{
+		Users:        memory.NewUserRepo(),
+		Readings:     memory.NewReadingRepo(),
+		Competitions: memory.NewCompetitionRepo(),
+		Freezes:      memory.NewStreakFreezeRepo(),
+		XPLedger:     memory.NewXPLedgerRepo(),
+		Achievements: memory.NewAchievementRepo(),
+		XPPolicies:   memory.NewXPPolicyRepo(),
+		LevelUps:     memory.NewLevelUpRepo(),
+		Series:       memory.NewSeriesRepo(),
+	}
+	lb := memory.NewLeaderboard()
+	links := memory.NewTelegramLinkRepo()
+	client := tgClient.NewClient(srv.URL, "TOKEN")
+	logReading := appReading.NewLogReadingHandler(memory.NewUnitOfWork(repos), memory.NewBookRepo(), lb, nil)
+
+	return &botEnv{
+		api:    api,
+		client: client,
+		bot:    appTelegram.NewBot(client, links, repos.Users, repos.Competitions, lb, logReading),
+		users:  repos.Users.(*memory.UserRepo),
+		links:  links,
+		comps:  repos.Competitions.(*memory.CompetitionRepo),
+	}
+}
+
+// send delivers m through getUpdates, lets the bot answer and returns the replies.
+func (e *botEnv) send(t *testing.T, m message) []sentMessage {
+	t.Helper()
+
+	e.api.mu.Lock()
+	e.api.nextID++
+	e.api.pending = append(e.api.pending, map[string]any{
+		"update_id": e.api.nextID,
+		"message": map[string]any{
+			"text": m.text,
+			"chat": map[string]any{"id": m.chatID, "type": m.chatType},
+			"from": map[string]any{"id": m.fromID, "username": "reader"},
+		},
+	})
+	e.api.sent = nil
+	e.api.mu.Unlock()
+
+	ctx := context.Background()
+	updates, err := e.client.GetUpdates(ctx, 0, 0)
+	if err != nil {
+		t.Fatalf("GetUpdates: %v", err)
+	}
+	for _, up := range updates {
+		e.bot.HandleUpdate(ctx, up)
+	}
+
+	e.api.mu.Lock()
+	defer e.api.mu.Unlock()
+	return e.api.sent
+}
+
+func (e *botEnv) newUser(t *testing.T, name string) *user.User {
+	t.Helper()
+
+	u := user.NewUser(strings.ToLower(name)+"@example.com", name, "secret123")
+	if err := e.users.Save(u); err != nil {
+		t.Fatalf("save user: %v", err)
+	}
+	return u
+}
+
+func (e *botEnv) linkCode(t *testing.T, userID string) string {
+	t.Helper()
+
+	c, err := user.NewTelegramLinkCode(userID, time.Now().UTC())
+	if err != nil {
+		t.Fatalf("new link code: %v", err)
+	}
+	if err := e.links.SaveCode(c); err != nil {
+		t.Fatalf("save link code: %v", err)
+	}
+	return c.Code
+}
+
+func (e *botEnv) link(t *testing.T, userID string, telegramID int64) {
+	t.Helper()
+
+	if err := e.links.Link(userID, telegramID); err != nil {
+		t.Fatalf("link: %v", err)
+	}
+}
+
+// reply expects exactly one answer in the chat and returns its text.
+func reply(t *testing.T, sent []sentMessage, chatID int64) string {
+	t.Helper()
+
+	if len(sent) != 1 {
+		t.Fatalf("got %d replies, want 1: %+v", len(sent), sent)
+	}
+	if sent[0].ChatID != chatID {
+		t.Fatalf("replied to chat %d, want %d", sent[0].ChatID, chatID)
+	}
+	return sent[0].Text
+}
+
+func TestBotLink(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        func(code string) message
+		wantReply  string
+		wantLinked bool
+	}{
+		{
+			name:       "private chat links the sender",
+			msg:        func(code string) message { return private(7, "/link "+code) },
+			wantReply:  "Linked to Alice",
+			wantLinked: true,
+		},
+		{
+			name:       "code is case insensitive",
+			msg:        func(code string) message { return private(7, "/link "+strings.ToLower(code)) },
+			wantReply:  "Linked to Alice",
+			wantLinked: true,
+		},
+		{
+			name:      "unknown code",
+			msg:       func(string) message { return private(7, "/link NOPE") },
+			wantReply: "invalid or has expired",
+		},
+		{
+			name:      "group chat is refused",
+			msg:       func(code string) message { return group(7, "/link@PowerBookBot "+code) },
+			wantReply: "private chat",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newBotEnv(t)
+			alice := env.newUser(t, "Alice")
+			code := env.linkCode(t, alice.ID)
+
+			m := tt.msg(code)
+			got := reply(t, env.send(t, m), m.chatID)
+			if !strings.Contains(got, tt.wantReply) {
+				t.Errorf("reply = %q, want it to contain %q", got, tt.wantReply)
+			}
+
+			linked, err := env.links.UserID(7)
+			if err != nil {
+				t.Fatalf("UserID: %v", err)
+			}
+			if tt.wantLinked && linked != alice.ID {
+				t.Errorf("telegram user 7 linked to %q, want %q", linked, alice.ID)
+			}
+			if !tt.wantLinked && linked != "" {
+				t.Errorf("telegram user 7 linked to %q, want no link", linked)
+			}
+			if id, _ := env.links.UserID(-100); id != "" {
+				t.Errorf("group chat linked to %q", id)
+			}
+		})
+	}
+}
+
+func TestBotLinkCodeWorksOnce(t *testing.T) {
+	env := newBotEnv(t)
+	alice := env.newUser(t, "Alice")
+	code := env.linkCode(t, alice.ID)
+
+	if got := reply(t, env.send(t, private(7, "/link "+code)), 7); !strings.Contains(got, "Linked") {
+		t.Fatalf("first use: %q", got)
+	}
+	if got := reply(t, env.send(t, private(8, "/link "+code)), 8); !strings.Contains(got, "invalid or has expired") {
+		t.Errorf("second use: %q, want the code refused", got)
+	}
+	if id, _ := env.links.UserID(8); id != "" {
+		t.Errorf("telegram user 8 linked to %q by a spent code", id)
+	}
+}
+
+func TestBotLog(t *testing.T) {
+	tests := []struct {
+		name        string
+		msg         message
+		wantReply   string
+		wantMinutes int
+	}{
+		{
+			name:        "linked sender logs reading",
+			msg:         private(7, "/log 30 kindle"),
+			wantReply:   "Logged 30 min. Streak: 1 days, total: 30 min.",
+			wantMinutes: 30,
+		},
+		{
+			name:      "minutes must be a number",
+			msg:       private(7, "/log half"),
+			wantReply: "Minutes must be a number",
+		},
+		{
+			name:      "missing minutes",
+			msg:       private(7, "/log"),
+			wantReply: "Usage: /log MINUTES",
+		},
+		{
+			name:      "invalid minutes are rejected by the use case",
+			msg:       private(7, "/log -5"),
+			wantReply: "minutes",
+		},
+		{
+			name:      "unlinked sender",
+			msg:       private(9, "/log 30"),
+			wantReply: "not linked yet",
+		},
+		{
+			name:      "linked sender in a group chat",
+			msg:       group(7, "/log@PowerBookBot 30"),
+			wantReply: "private chat",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newBotEnv(t)
+			alice := env.newUser(t, "Alice")
+			env.link(t, alice.ID, 7)
+
+			got := reply(t, env.send(t, tt.msg), tt.msg.chatID)
+			if !strings.Contains(got, tt.wantReply) {
+				t.Errorf("reply = %q, want it to contain %q", got, tt.wantReply)
+			}
+
+			u, err := env.users.Get(alice.ID)
+			if err != nil {
+				t.Fatalf("get user: %v", err)
+			}
+			if u.TotalMinutes != tt.wantMinutes {
+				t.Errorf("total minutes = %d, want %d", u.TotalMinutes, tt.wantMinutes)
+			}
+		})
+	}
+}
+
+func TestBotRank(t *testing.T) {
+	tests := []struct {
+		name      string
+		join      bool
+		log       string // logged before asking for the rank, if any
+		msg       message
+		wantReply string
+	}{
+		{
+			name:      "ranked in an open competition",
+			join:      true,
+			log:       "/log 30",
+			msg:       private(7, "/rank"),
+			wantReply: "Sprint: #1 of 2, 30 pts",
+		},
+		{
+			name:      "not in any competition",
+			msg:       private(7, "/rank"),
+			wantReply: "You are not ranked in any open competition.",
+		},
+		{
+			name:      "unlinked sender",
+			join:      true,
+			msg:       private(9, "/rank"),
+			wantReply: "not linked yet",
+		},
+		{
+			name:      "group chat",
+			join:      true,
+			msg:       group(7, "/rank"),
+			wantReply: "private chat",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newBotEnv(t)
+			alice := env.newUser(t, "Alice")
+			bob := env.newUser(t, "Bob")
+			env.link(t, alice.ID, 7)
+
+			if tt.join {
+				now := time.Now().UTC()
+				cmp, err := competition.NewCompetition("Sprint", now.AddDate(0, 0, -1), now.AddDate(0, 0, 7), competition.Rules{PointsPerMinute: 1})
+				if err != nil {
+					t.Fatalf("new competition: %v", err)
+				}
+				if err := env.comps.Create(cmp); err != nil {
+					t.Fatalf("create competition: %v", err)
+				}
+				for _, id := range []string{alice.ID, bob.ID} {
+					if err := env.comps.SaveParticipant(cmp.ID, competition.NewParticipant(id)); err != nil {
+						t.Fatalf("save participant: %v", err)
+					}
+				}
+			}
+			if tt.log != "" {
+				env.send(t, private(7, tt.log))
+			}
+
+			got := reply(t, env.send(t, tt.msg), tt.msg.chatID)
+			if !strings.Contains(got, tt.wantReply) {
+				t.Errorf("reply = %q, want it to contain %q", got, tt.wantReply)
+			}
+		})
+	}
+}
+
+func TestBotIgnoresGroupChatter(t *testing.T) {
+	env := newBotEnv(t)
+
+	if sent := env.send(t, group(7, "good morning everyone")); len(sent) != 0 {
+		t.Errorf("bot answered plain group chatter: %+v", sent)
+	}
+}
+
+func TestBotIgnoresBlankMessages(t *testing.T) {
+	env := newBotEnv(t)
+
+	for _, text := range []string{" ", "\n", "\t \n"} {
+		if sent := env.send(t, private(7, text)); len(sent) != 0 {
+			t.Errorf("bot answered %q: %+v", text, sent)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package telegram
+
+import (
+	"context"
+
+	"github.com/bakhtybayevn/powerbook/internal/domain/notification"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// Channel delivers notifications to the user's linked chat. Users without
+// a linked chat are skipped.
+type Channel struct {
+	Client ports.TelegramBot
+	Links  ports.TelegramLinkRepository
+}
+
+func NewChannel(client ports.TelegramBot, links ports.TelegramLinkRepository) *Channel {
+	return &Channel{Client: client, Links: links}
+}
+
+func (c *Channel) Channel() notification.Channel {
+	return notification.ChannelTelegram
+}
+
+func (c *Channel) Deliver(n *notification.Notification) error {
+	chatID, err := c.Links.ChatID(n.UserID)
+	if err != nil || chatID == 0 {
+		return err
+	}
+
+	text := n.Title
+	if n.Body != "" {
+		text += "\n" + n.Body
+	}
+	return c.Client.SendMessage(context.Background(), chatID, text)
+}
@@ -0,0 +1,34 @@
+package telegram
+
+import (
+	"time"
+
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/user"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// IssueLinkCodeHandler hands out the one-time code a user sends to the bot
+// to link their Telegram account.
+type IssueLinkCodeHandler struct {
+	Links ports.TelegramLinkRepository
+}
+
+func NewIssueLinkCodeHandler(links ports.TelegramLinkRepository) *IssueLinkCodeHandler {
+	return &IssueLinkCodeHandler{Links: links}
+}
+
+func (h *IssueLinkCodeHandler) Handle(userID string) (*user.TelegramLinkCode, error) {
+	if userID == "" {
+		return nil, core.New(core.AuthError, "user id missing")
+	}
+
+	c, err := user.NewTelegramLinkCode(userID, time.Now().UTC())
+	if err != nil {
+		return nil, core.New(core.ServerError, err.Error())
+	}
+	if err := h.Links.SaveCode(c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
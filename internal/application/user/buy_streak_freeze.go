@@ -0,0 +1,49 @@
+package user
+
+import (
+	appXP "github.com/bakhtybayevn/powerbook/internal/application/xp"
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/user"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+type BuyStreakFreezeCommand struct {
+	UserID string
+}
+
+// BuyStreakFreezeHandler spends XP on a freeze; the balance, the XP ledger
+// entry and the freeze event are written in one transaction.
+type BuyStreakFreezeHandler struct {
+	UoW ports.UnitOfWork
+}
+
+func NewBuyStreakFreezeHandler(uow ports.UnitOfWork) *BuyStreakFreezeHandler {
+	return &BuyStreakFreezeHandler{UoW: uow}
+}
+
+func (h *BuyStreakFreezeHandler) Handle(cmd BuyStreakFreezeCommand) (*user.User, error) {
+	if cmd.UserID == "" {
+		return nil, core.New(core.AuthError, "user id missing")
+	}
+
+	var u *user.User
+	err := h.UoW.Do(func(tx ports.Repositories) error {
+		var err error
+		u, err = tx.Users.Get(cmd.UserID)
+		if err != nil {
+			return core.New(core.NotFoundError, "user not found")
+		}
+
+		if err := u.BuyStreakFreeze(); err != nil {
+			return core.New(core.ValidationError, err.Error())
+		}
+
+		_, err = appXP.SaveUser(tx, u)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return u, nil
+}
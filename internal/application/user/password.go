@@ -0,0 +1,208 @@
+package user
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	appEmail "github.com/bakhtybayevn/powerbook/internal/application/email"
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/user"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+func validatePassword(password string) error {
+	if len(password) < 6 {
+		return core.New(core.ValidationError, "password must be at least 6 characters")
+	}
+	if len(password) > 64 {
+		return core.New(core.ValidationError, "password too long")
+	}
+	return nil
+}
+
+type ChangePasswordCommand struct {
+	UserID          string
+	CurrentPassword string
+	NewPassword     string
+}
+
+// ChangePasswordHandler replaces the password of a signed-in user. Every
+// other session ends; the caller gets a fresh token to stay signed in.
+type ChangePasswordHandler struct {
+	Repo  ports.UserRepository
+	Token ports.TokenService
+}
+
+func NewChangePasswordHandler(repo ports.UserRepository, token ports.TokenService) *ChangePasswordHandler {
+	return &ChangePasswordHandler{Repo: repo, Token: token}
+}
+
+func (h *ChangePasswordHandler) Handle(cmd ChangePasswordCommand) (string, error) {
+	u, err := h.Repo.Get(cmd.UserID)
+	if err != nil {
+		return "", core.New(core.NotFoundError, "user not found")
+	}
+	if !u.CheckPassword(cmd.CurrentPassword) {
+		return "", core.New(core.ValidationError, "current password is incorrect")
+	}
+	if err := validatePassword(cmd.NewPassword); err != nil {
+		return "", err
+	}
+
+	hash, err := user.HashPassword(cmd.NewPassword)
+	if err != nil {
+		return "", core.New(core.ServerError, err.Error())
+	}
+	if err := h.Repo.UpdatePassword(u.ID, hash); err != nil {
+		return "", err
+	}
+	return h.Token.GenerateToken(u.ID)
+}
+
+type RequestPasswordResetCommand struct {
+	Email string
+}
+
+// RequestPasswordResetHandler emails a single-use reset link. Only the
+// latest link of a user works, and a new one is only issued once the last
+// is PasswordResetCooldown old.
+type RequestPasswordResetHandler struct {
+	Repo     ports.UserRepository
+	UoW      ports.UnitOfWork // the reset and its email are stored together
+	Mailer   *appEmail.Mailer // nil while email is not configured
+	ResetURL string           // page that takes ?token=; the email shows the bare token without it
+
+	now func() time.Time
+}
+
+func NewRequestPasswordResetHandler(
+	repo ports.UserRepository,
+	uow ports.UnitOfWork,
+	mailer *appEmail.Mailer,
+	resetURL string,
+) *RequestPasswordResetHandler {
+	return &RequestPasswordResetHandler{Repo: repo, UoW: uow, Mailer: mailer, ResetURL: resetURL, now: time.Now}
+}
+
+// Handle succeeds for unknown addresses too, so the endpoint doesn't reveal
+// who has an account.
+func (h *RequestPasswordResetHandler) Handle(cmd RequestPasswordResetCommand) error {
+	if h.Mailer == nil {
+		return core.New(core.ServerError, "password reset by email is not available")
+	}
+	email := strings.TrimSpace(cmd.Email)
+	if email == "" {
+		return core.New(core.ValidationError, "email is required")
+	}
+
+	u, err := h.Repo.FindByEmail(email)
+	if err != nil || u == nil {
+		return nil
+	}
+
+	now := h.now().UTC()
+	token, reset, err := user.NewPasswordResetToken(u.ID, now)
+	if err != nil {
+		return core.New(core.ServerError, err.Error())
+	}
+
+	data := map[string]any{
+		"Name":    u.DisplayName,
+		"Token":   token,
+		"Link":    h.link(token),
+		"Minutes": int(user.PasswordResetTTL.Minutes()),
+	}
+	// one email per user and cooldown window, even if two requests race
+	window := now.Truncate(user.PasswordResetCooldown).Unix()
+	key := fmt.Sprintf("password_reset:%s:%d", u.ID, window)
+	msg, err := h.Mailer.Compose(u.Email, appEmail.TemplatePasswordReset, data, key)
+	if err != nil {
+		return err
+	}
+	// the email holds the only copy of the token
+	msg.Sensitive = true
+
+	return h.UoW.Do(func(tx ports.Repositories) error {
+		// a recent link stays the one that works; the request looks the same
+		latest, err := tx.Resets.LatestByUser(u.ID)
+		if err != nil && !core.Is(err, core.NotFoundError) {
+			return err
+		}
+		if latest != nil && latest.CoolingDown(now) {
+			return nil
+		}
+
+		queued, err := tx.EmailOutbox.Enqueue(msg)
+		if err != nil || !queued {
+			return err // a racing request already sent this window's email
+		}
+		if err := tx.Resets.DeleteByUser(u.ID); err != nil {
+			return err
+		}
+		return tx.Resets.Save(reset)
+	})
+}
+
+func (h *RequestPasswordResetHandler) link(token string) string {
+	if h.ResetURL == "" {
+		return ""
+	}
+	sep := "?"
+	if strings.Contains(h.ResetURL, "?") {
+		sep = "&"
+	}
+	return h.ResetURL + sep + "token=" + url.QueryEscape(token)
+}
+
+type ResetPasswordCommand struct {
+	Token       string
+	NewPassword string
+}
+
+// ResetPasswordHandler sets a new password with a token from a reset email
+// and ends every session of the user.
+type ResetPasswordHandler struct {
+	Repo   ports.UserRepository
+	Resets ports.PasswordResetRepository
+}
+
+func NewResetPasswordHandler(repo ports.UserRepository, resets ports.PasswordResetRepository) *ResetPasswordHandler {
+	return &ResetPasswordHandler{Repo: repo, Resets: resets}
+}
+
+func (h *ResetPasswordHandler) Handle(cmd ResetPasswordCommand) error {
+	token := strings.TrimSpace(cmd.Token)
+	if token == "" {
+		return core.New(core.ValidationError, "token is required")
+	}
+	if err := validatePassword(cmd.NewPassword); err != nil {
+		return err
+	}
+
+	// taking the token uses it up, even if the rest fails
+	reset, err := h.Resets.Take(user.HashPasswordResetToken(token))
+	if err != nil {
+		if core.Is(err, core.NotFoundError) {
+			return core.New(core.ValidationError, "reset token is invalid or has expired")
+		}
+		return err
+	}
+	if reset.Expired(time.Now().UTC()) {
+		return core.New(core.ValidationError, "reset token is invalid or has expired")
+	}
+
+	u, err := h.Repo.Get(reset.UserID)
+	if err != nil {
+		return core.New(core.ValidationError, "reset token is invalid or has expired")
+	}
+	hash, err := user.HashPassword(cmd.NewPassword)
+	if err != nil {
+		return core.New(core.ServerError, err.Error())
+	}
+	if err := h.Repo.UpdatePassword(u.ID, hash); err != nil {
+		return err
+	}
+	return h.Resets.DeleteByUser(u.ID)
+}
@@ -0,0 +1,344 @@
+package user
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/bakhtybayevn/powerbook/internal/adapters/memory"
+	appEmail "github.com/bakhtybayevn/powerbook/internal/application/email"
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/email"
+	"github.com/bakhtybayevn/powerbook/internal/domain/user"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// passwordFixture is one user with email set up, on the in-memory adapters.
+type passwordFixture struct {
+	repos  ports.Repositories
+	uow    ports.UnitOfWork
+	mailer *appEmail.Mailer
+	tokens *memory.TokenService
+	outbox *recordingOutbox
+	sent   *recordingSender
+	user   *user.User
+	clock  time.Time // when reset requests are made; zero means now
+}
+
+// recordingOutbox keeps the last saved state of every message.
+type recordingOutbox struct {
+	ports.EmailOutboxRepository
+	saved map[string]email.OutboxMessage
+}
+
+func (o *recordingOutbox) Save(m *email.OutboxMessage) error {
+	o.saved[m.ID] = *m
+	return o.EmailOutboxRepository.Save(m)
+}
+
+// recordingSender keeps what the relay hands to the mail server.
+type recordingSender struct {
+	messages []email.Message
+}
+
+func (s *recordingSender) Send(ctx context.Context, m email.Message) error {
+	s.messages = append(s.messages, m)
+	return nil
+}
+
+func newPasswordFixture(t *testing.T) *passwordFixture {
+	t.Helper()
+
+	outbox := &recordingOutbox{EmailOutboxRepository: memory.NewEmailOutboxRepo(), saved: map[string]email.OutboxMessage{}}
+	repos := ports.Repositories{
+		Users:       memory.NewUserRepo(),
+		Resets:      memory.NewPasswordResetRepo(),
+		EmailOutbox: outbox,
+	}
+	u := user.NewUser("reader@example.com", "Reader", "secret123")
+	if err := repos.Users.Save(u); err != nil {
+		t.Fatalf("save user: %v", err)
+	}
+	return &passwordFixture{
+		repos:  repos,
+		uow:    memory.NewUnitOfWork(repos),
+		mailer: appEmail.NewMailer(repos.EmailOutbox),
+		tokens: memory.NewTokenService(repos.Users),
+		outbox: outbox,
+		sent:   &recordingSender{},
+		user:   u,
+	}
+}
+
+var resetTokenPattern = regexp.MustCompile(`[0-9a-f]{64}`)
+
+func (f *passwordFixture) requestHandler() *RequestPasswordResetHandler {
+	h := NewRequestPasswordResetHandler(f.repos.Users, f.uow, f.mailer, "https://powerbook.test/reset")
+	if !f.clock.IsZero() {
+		h.now = func() time.Time { return f.clock }
+	}
+	return h
+}
+
+// requestReset asks for a reset, relays the email and returns the token in it.
+func (f *passwordFixture) requestReset(t *testing.T) string {
+	t.Helper()
+
+	if err := f.requestHandler().Handle(RequestPasswordResetCommand{Email: f.user.Email}); err != nil {
+		t.Fatalf("request reset: %v", err)
+	}
+	f.relay(t)
+
+	if len(f.sent.messages) == 0 {
+		t.Fatalf("no reset email was sent")
+	}
+	token := resetTokenPattern.FindString(f.sent.messages[len(f.sent.messages)-1].Text)
+	if token == "" {
+		t.Fatalf("reset email carries no token:\n%s", f.sent.messages[len(f.sent.messages)-1].Text)
+	}
+	return token
+}
+
+func (f *passwordFixture) relay(t *testing.T) {
+	t.Helper()
+
+	if _, err := appEmail.NewRelay(f.repos.EmailOutbox, f.sent).Handle(time.Now().UTC().Add(time.Minute)); err != nil {
+		t.Fatalf("relay: %v", err)
+	}
+}
+
+func (f *passwordFixture) reset(token, password string) error {
+	return NewResetPasswordHandler(f.repos.Users, f.repos.Resets).Handle(ResetPasswordCommand{Token: token, NewPassword: password})
+}
+
+func (f *passwordFixture) stored(t *testing.T) *user.User {
+	t.Helper()
+
+	u, err := f.repos.Users.Get(f.user.ID)
+	if err != nil {
+		t.Fatalf("get user: %v", err)
+	}
+	return u
+}
+
+func TestResetPassword(t *testing.T) {
+	tests := []struct {
+		name     string
+		token    func(t *testing.T, f *passwordFixture) string
+		password string
+		wantErr  core.ErrorType
+	}{
+		{
+			name:     "token from the email",
+			token:    func(t *testing.T, f *passwordFixture) string { return f.requestReset(t) },
+			password: "newpass1",
+		},
+		{
+			name: "token used twice",
+			token: func(t *testing.T, f *passwordFixture) string {
+				token := f.requestReset(t)
+				if err := f.reset(token, "otherpass"); err != nil {
+					t.Fatalf("first reset: %v", err)
+				}
+				return token
+			},
+			password: "newpass1",
+			wantErr:  core.ValidationError,
+		},
+		{
+			name: "token replaced by a newer request",
+			token: func(t *testing.T, f *passwordFixture) string {
+				token := f.requestReset(t)
+				f.clock = time.Now().UTC().Add(user.PasswordResetCooldown)
+				f.requestReset(t)
+				return token
+			},
+			password: "newpass1",
+			wantErr:  core.ValidationError,
+		},
+		{
+			name: "expired token",
+			token: func(t *testing.T, f *passwordFixture) string {
+				token, reset, err := user.NewPasswordResetToken(f.user.ID, time.Now().UTC().Add(-2*user.PasswordResetTTL))
+				if err != nil {
+					t.Fatalf("new reset token: %v", err)
+				}
+				if err := f.repos.Resets.Save(reset); err != nil {
+					t.Fatalf("save reset: %v", err)
+				}
+				return token
+			},
+			password: "newpass1",
+			wantErr:  core.ValidationError,
+		},
+		{
+			name:     "unknown token",
+			token:    func(*testing.T, *passwordFixture) string { return "not-a-token" },
+			password: "newpass1",
+			wantErr:  core.ValidationError,
+		},
+		{
+			name:     "password too short",
+			token:    func(t *testing.T, f *passwordFixture) string { return f.requestReset(t) },
+			password: "short",
+			wantErr:  core.ValidationError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newPasswordFixture(t)
+			session, err := f.tokens.GenerateToken(f.user.ID)
+			if err != nil {
+				t.Fatalf("generate token: %v", err)
+			}
+			token := tt.token(t, f)
+			before := f.stored(t)
+
+			err = f.reset(token, tt.password)
+			after := f.stored(t)
+			if tt.wantErr != "" {
+				if !core.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %s", err, tt.wantErr)
+				}
+				if after.PasswordHash != before.PasswordHash || after.SessionVersion != before.SessionVersion {
+					t.Errorf("a refused reset changed the password")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("reset: %v", err)
+			}
+
+			if !after.CheckPassword(tt.password) {
+				t.Errorf("new password does not work")
+			}
+			if after.CheckPassword("secret123") {
+				t.Errorf("old password still works")
+			}
+			if _, err := f.tokens.ParseToken(session); err == nil {
+				t.Errorf("session from before the reset is still valid")
+			}
+		})
+	}
+}
+
+func TestRequestPasswordReset(t *testing.T) {
+	t.Run("unknown address looks the same and sends nothing", func(t *testing.T) {
+		f := newPasswordFixture(t)
+		h := NewRequestPasswordResetHandler(f.repos.Users, f.uow, f.mailer, "")
+		if err := h.Handle(RequestPasswordResetCommand{Email: "nobody@example.com"}); err != nil {
+			t.Fatalf("request reset: %v", err)
+		}
+		f.relay(t)
+		if len(f.sent.messages) != 0 {
+			t.Errorf("sent %d emails for an unknown address", len(f.sent.messages))
+		}
+	})
+
+	t.Run("email is required", func(t *testing.T) {
+		f := newPasswordFixture(t)
+		h := NewRequestPasswordResetHandler(f.repos.Users, f.uow, f.mailer, "")
+		if err := h.Handle(RequestPasswordResetCommand{Email: "  "}); !core.Is(err, core.ValidationError) {
+			t.Errorf("err = %v, want a validation error", err)
+		}
+	})
+
+	t.Run("another request within the cooldown keeps the first link and sends nothing", func(t *testing.T) {
+		f := newPasswordFixture(t)
+		token := f.requestReset(t)
+
+		for i := 0; i < 3; i++ {
+			f.clock = time.Now().UTC().Add(user.PasswordResetCooldown - time.Minute)
+			if err := f.requestHandler().Handle(RequestPasswordResetCommand{Email: f.user.Email}); err != nil {
+				t.Fatalf("request reset: %v", err)
+			}
+		}
+		f.relay(t)
+		if len(f.sent.messages) != 1 {
+			t.Errorf("sent %d emails, want 1", len(f.sent.messages))
+		}
+		if err := f.reset(token, "newpass1"); err != nil {
+			t.Errorf("the first link stopped working: %v", err)
+		}
+	})
+
+	t.Run("the token is only kept as a hash and wiped from the outbox once sent", func(t *testing.T) {
+		f := newPasswordFixture(t)
+		token := f.requestReset(t)
+
+		if _, err := f.repos.Resets.Take(token); err == nil {
+			t.Errorf("the raw token is stored as a reset")
+		}
+
+		if !resetTokenPattern.MatchString(f.sent.messages[0].HTML) {
+			t.Errorf("the sent email has no token in its HTML part")
+		}
+		if len(f.outbox.saved) != 1 {
+			t.Fatalf("%d outbox messages saved, want 1", len(f.outbox.saved))
+		}
+		for _, m := range f.outbox.saved {
+			if m.Status != email.StatusSent {
+				t.Errorf("status = %s, want sent", m.Status)
+			}
+			if m.Text != "" || m.HTML != "" {
+				t.Errorf("the sent reset email kept its body in the outbox")
+			}
+		}
+	})
+}
+
+func TestChangePassword(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  string
+		password string
+		wantErr  core.ErrorType
+	}{
+		{name: "changed", current: "secret123", password: "newpass1"},
+		{name: "wrong current password", current: "wrong123", password: "newpass1", wantErr: core.ValidationError},
+		{name: "new password too short", current: "secret123", password: "short", wantErr: core.ValidationError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newPasswordFixture(t)
+			session, err := f.tokens.GenerateToken(f.user.ID)
+			if err != nil {
+				t.Fatalf("generate token: %v", err)
+			}
+
+			fresh, err := NewChangePasswordHandler(f.repos.Users, f.tokens).Handle(ChangePasswordCommand{
+				UserID: f.user.ID, CurrentPassword: tt.current, NewPassword: tt.password,
+			})
+			if tt.wantErr != "" {
+				if !core.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %s", err, tt.wantErr)
+				}
+				if _, err := f.tokens.ParseToken(session); err != nil {
+					t.Errorf("a refused change ended the session: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("change password: %v", err)
+			}
+
+			if _, err := f.tokens.ParseToken(session); err == nil {
+				t.Errorf("the old session is still valid")
+			}
+			if id, err := f.tokens.ParseToken(fresh); err != nil || id != f.user.ID {
+				t.Errorf("the new token does not work: %q, %v", id, err)
+			}
+
+			// saving a copy loaded before the change must not bring the old password back
+			if err := f.repos.Users.Save(f.user); err != nil {
+				t.Fatalf("save stale user: %v", err)
+			}
+			if !f.stored(t).CheckPassword(tt.password) {
+				t.Errorf("saving a stale copy reverted the password")
+			}
+		})
+	}
+}
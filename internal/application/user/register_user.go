@@ -44,11 +44,8 @@ func (h *RegisterUserHandler) Handle(cmd RegisterUserCommand) (*user.User, error
 	}
 
 	// password
-	if len(cmd.Password) < 6 {
-		return nil, core.New(core.ValidationError, "password must be at least 6 characters")
-	}
-	if len(cmd.Password) > 64 {
-		return nil, core.New(core.ValidationError, "password too long")
+	if err := validatePassword(cmd.Password); err != nil {
+		return nil, err
 	}
 
 	// unique email check
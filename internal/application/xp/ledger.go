@@ -0,0 +1,124 @@
+package xp
+
+import (
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/user"
+	"github.com/bakhtybayevn/powerbook/internal/domain/xp"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// SaveUser saves the user together with what was recorded on it since it
+// was loaded: XP ledger entries and streak freeze events. Levels the new XP
+// reaches for the first time are recorded and their rewards applied, and
+// returned to the caller. Every XP change goes through here, so call it
+// inside a unit of work to keep users.xp, the ledger and level-ups in step.
+func SaveUser(tx ports.Repositories, u *user.User) ([]*xp.LevelUp, error) {
+	txs := u.TakeXPTransactions()
+	gained := 0
+	for _, t := range txs {
+		gained += t.Amount
+	}
+
+	var reached []*xp.LevelUp
+	if gained > 0 {
+		policy, err := tx.XPPolicies.Current()
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range policy.LevelUps(u.ID, u.XP-gained, u.XP) {
+			// levels lost by spending XP and regained are not rewarded twice
+			ok, err := tx.LevelUps.Record(e)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+			u.RewardStreakFreezes(e.Reward.StreakFreezes)
+			reached = append(reached, e)
+		}
+	}
+
+	if err := tx.Users.Save(u); err != nil {
+		return nil, core.New(core.ServerError, "failed to update user")
+	}
+	for _, t := range txs {
+		if err := tx.XPLedger.Append(t); err != nil {
+			return nil, err
+		}
+	}
+	for _, e := range u.TakeFreezeEvents() {
+		if err := tx.Freezes.SaveEvent(e); err != nil {
+			return nil, err
+		}
+	}
+	return reached, nil
+}
+
+type RecomputeXPCommand struct {
+	UserID string
+}
+
+// RecomputeXPResult reports a balance that was rebuilt from the ledger.
+type RecomputeXPResult struct {
+	UserID     string
+	PreviousXP int
+	XP         int
+}
+
+// RecomputeXPHandler rebuilds users.xp from the ledger, repairing balances
+// that drifted from their history.
+type RecomputeXPHandler struct {
+	UoW   ports.UnitOfWork
+	Users ports.UserRepository
+}
+
+func NewRecomputeXPHandler(uow ports.UnitOfWork, users ports.UserRepository) *RecomputeXPHandler {
+	return &RecomputeXPHandler{UoW: uow, Users: users}
+}
+
+func (h *RecomputeXPHandler) Handle(cmd RecomputeXPCommand) (*RecomputeXPResult, error) {
+	var res *RecomputeXPResult
+	err := h.UoW.Do(func(tx ports.Repositories) error {
+		u, err := tx.Users.Get(cmd.UserID)
+		if err != nil {
+			return core.New(core.NotFoundError, "user not found")
+		}
+
+		ledger, err := tx.XPLedger.ListByUser(u.ID)
+		if err != nil {
+			return err
+		}
+
+		previous := u.RecomputeXP(ledger)
+		if err := tx.Users.Save(u); err != nil {
+			return core.New(core.ServerError, "failed to update user")
+		}
+		res = &RecomputeXPResult{UserID: u.ID, PreviousXP: previous, XP: u.XP}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// HandleAll recomputes every user and returns only the balances that changed.
+func (h *RecomputeXPHandler) HandleAll() ([]*RecomputeXPResult, error) {
+	users, err := h.Users.ListAll()
+	if err != nil {
+		return nil, err
+	}
+
+	changed := make([]*RecomputeXPResult, 0)
+	for _, u := range users {
+		res, err := h.Handle(RecomputeXPCommand{UserID: u.ID})
+		if err != nil {
+			return nil, err
+		}
+		if res.PreviousXP != res.XP {
+			changed = append(changed, res)
+		}
+	}
+	return changed, nil
+}
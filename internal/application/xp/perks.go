@@ -0,0 +1,39 @@
+package xp
+
+import (
+	"github.com/bakhtybayevn/powerbook/internal/domain/user"
+	"github.com/bakhtybayevn/powerbook/internal/domain/xp"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// PerksReader works out which lasting level rewards a user holds.
+type PerksReader struct {
+	Policies ports.XPPolicyRepository
+	LevelUps ports.LevelUpRepository
+}
+
+func NewPerksReader(policies ports.XPPolicyRepository, levelUps ports.LevelUpRepository) *PerksReader {
+	return &PerksReader{Policies: policies, LevelUps: levelUps}
+}
+
+// For returns the perks of every level up to the highest one the user has
+// reached. That can be above the current level once XP is spent, and below
+// it for XP earned before level-ups were recorded.
+func (r *PerksReader) For(u *user.User) (xp.Perks, error) {
+	policy, err := r.Policies.Current()
+	if err != nil {
+		return xp.Perks{}, err
+	}
+	highest, _ := policy.Level(u.XP)
+
+	reached, err := r.LevelUps.ListByUser(u.ID)
+	if err != nil {
+		return xp.Perks{}, err
+	}
+	for _, e := range reached {
+		if e.Level > highest {
+			highest = e.Level
+		}
+	}
+	return xp.PerksAt(highest), nil
+}
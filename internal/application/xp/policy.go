@@ -0,0 +1,84 @@
+package xp
+
+import (
+	"time"
+
+	"github.com/bakhtybayevn/powerbook/internal/core"
+	"github.com/bakhtybayevn/powerbook/internal/domain/xp"
+	"github.com/bakhtybayevn/powerbook/internal/ports"
+)
+
+// UpdatePolicyCommand changes the XP policy; nil fields keep their current
+// value. Every change is stored as a new policy version.
+type UpdatePolicyCommand struct {
+	ActorID string
+
+	FirstPlaceXP    *int
+	SecondPlaceXP   *int
+	ThirdPlaceXP    *int
+	TopHalfXP       *int
+	BottomHalfXP    *int
+	PerDayXP        *int
+	PerfectStreakXP *int
+
+	SeasonFirstXP      *int
+	SeasonSecondXP     *int
+	SeasonThirdXP      *int
+	SeasonTopHalfXP    *int
+	SeasonBottomHalfXP *int
+	SeasonWinXP        *int
+
+	Levels []xp.Level // replaces the whole table when non-nil
+}
+
+type UpdatePolicyHandler struct {
+	Policies ports.XPPolicyRepository
+}
+
+func NewUpdatePolicyHandler(policies ports.XPPolicyRepository) *UpdatePolicyHandler {
+	return &UpdatePolicyHandler{Policies: policies}
+}
+
+func (h *UpdatePolicyHandler) Handle(cmd UpdatePolicyCommand) (*xp.Policy, error) {
+	current, err := h.Policies.Current()
+	if err != nil {
+		return nil, err
+	}
+
+	next := *current
+	next.Version = current.Version + 1
+	next.CreatedBy = cmd.ActorID
+	next.CreatedAt = time.Now().UTC()
+
+	override(&next.FirstPlaceXP, cmd.FirstPlaceXP)
+	override(&next.SecondPlaceXP, cmd.SecondPlaceXP)
+	override(&next.ThirdPlaceXP, cmd.ThirdPlaceXP)
+	override(&next.TopHalfXP, cmd.TopHalfXP)
+	override(&next.BottomHalfXP, cmd.BottomHalfXP)
+	override(&next.PerDayXP, cmd.PerDayXP)
+	override(&next.PerfectStreakXP, cmd.PerfectStreakXP)
+	override(&next.SeasonFirstXP, cmd.SeasonFirstXP)
+	override(&next.SeasonSecondXP, cmd.SeasonSecondXP)
+	override(&next.SeasonThirdXP, cmd.SeasonThirdXP)
+	override(&next.SeasonTopHalfXP, cmd.SeasonTopHalfXP)
+	override(&next.SeasonBottomHalfXP, cmd.SeasonBottomHalfXP)
+	override(&next.SeasonWinXP, cmd.SeasonWinXP)
+	if cmd.Levels != nil {
+		next.Levels = cmd.Levels
+	}
+
+	if err := next.Validate(); err != nil {
+		return nil, core.New(core.ValidationError, err.Error())
+	}
+
+	if err := h.Policies.Create(&next); err != nil {
+		return nil, err
+	}
+	return &next, nil
+}
+
+func override(field *int, v *int) {
+	if v != nil {
+		*field = *v
+	}
+}
@@ -41,6 +41,8 @@ func Load() (*Config, error) {
 	// APP
 	bind("app.environment", "APP_ENV")
 	bind("app.port", "APP_PORT")
+	bind("app.storage", "APP_STORAGE")
+	bind("app.password_reset_url", "APP_PASSWORD_RESET_URL")
 
 	// DATABASE
 	bind("database.host", "POSTGRES_HOST")
@@ -59,6 +61,17 @@ func Load() (*Config, error) {
 	// JWT
 	bind("jwt.secret", "JWT_SECRET")
 
+	// TELEGRAM
+	bind("telegram.bot_token", "TELEGRAM_BOT_TOKEN")
+	bind("telegram.api_url", "TELEGRAM_API_URL")
+
+	// SMTP
+	bind("smtp.host", "SMTP_HOST")
+	bind("smtp.port", "SMTP_PORT")
+	bind("smtp.username", "SMTP_USERNAME")
+	bind("smtp.password", "SMTP_PASSWORD")
+	bind("smtp.from", "SMTP_FROM")
+
 	var cfg Config
 	if err := v.Unmarshal(&cfg); err != nil {
 		return nil, fmt.Errorf("config unmarshal error: %w", err)
@@ -4,8 +4,17 @@ type AppConfig struct {
 	Name        string `mapstructure:"name"`
 	Environment string `mapstructure:"environment"`
 	Port        int    `mapstructure:"port"`
+	// Storage selects the adapters: StoragePostgres (default) or StorageMemory
+	Storage string `mapstructure:"storage"`
+	// PasswordResetURL is the frontend page reset emails link to, with ?token= appended
+	PasswordResetURL string `mapstructure:"password_reset_url"`
 }
 
+const (
+	StoragePostgres = "postgres"
+	StorageMemory   = "memory" // no Postgres/Redis needed; data is lost on restart
+)
+
 type DatabaseConfig struct {
 	Host     string `mapstructure:"host"`
 	Port     int    `mapstructure:"port"`
@@ -26,9 +35,27 @@ type JWTConfig struct {
 	Secret string `mapstructure:"secret"`
 }
 
+// TelegramConfig enables the Telegram bot when BotToken is set.
+type TelegramConfig struct {
+	BotToken string `mapstructure:"bot_token"`
+	APIURL   string `mapstructure:"api_url"` // Bot API base URL; defaults to https://api.telegram.org
+}
+
+// SMTPConfig enables email when Host is set. Credentials are optional, so a
+// local MailHog-style server needs only host and port.
+type SMTPConfig struct {
+	Host     string `mapstructure:"host"`
+	Port     int    `mapstructure:"port"`
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+	From     string `mapstructure:"from"` // e.g. "PowerBook <no-reply@powerbook.app>"
+}
+
 type Config struct {
 	App      AppConfig      `mapstructure:"app"`
 	Database DatabaseConfig `mapstructure:"database"`
 	Redis    RedisConfig    `mapstructure:"redis"`
 	JWT      JWTConfig      `mapstructure:"jwt"`
+	Telegram TelegramConfig `mapstructure:"telegram"`
+	SMTP     SMTPConfig     `mapstructure:"smtp"`
 }
@@ -0,0 +1,87 @@
+package achievement
+
+import "time"
+
+// Catalog ids. They are stored with each unlock, so never rename one.
+const (
+	FirstLog     = "first_log"
+	Streak7      = "streak_7"
+	Streak30     = "streak_30"
+	Streak100    = "streak_100"
+	Minutes10k   = "minutes_10k"
+	FirstWin     = "first_competition_win"
+	FirstGiftOut = "gift_given"
+)
+
+// Progress is what the catalog is checked against.
+type Progress struct {
+	TotalMinutes    int
+	StreakDays      int
+	CompetitionWins int
+	GiftsGiven      int // gifts the user confirmed giving
+}
+
+// Definition is one collectible badge. XPReward may be zero.
+type Definition struct {
+	ID          string
+	Name        string
+	Description string
+	XPReward    int
+
+	reached func(p Progress) bool
+}
+
+// Reached reports whether p meets the definition's condition.
+func (d Definition) Reached(p Progress) bool {
+	return d.reached(p)
+}
+
+var catalog = []Definition{
+	{ID: FirstLog, Name: "First Page", Description: "Log your first reading session", XPReward: 10,
+		reached: func(p Progress) bool { return p.TotalMinutes > 0 }},
+	{ID: Streak7, Name: "Week Streak", Description: "Read 7 days in a row", XPReward: 25,
+		reached: func(p Progress) bool { return p.StreakDays >= 7 }},
+	{ID: Streak30, Name: "Month Streak", Description: "Read 30 days in a row", XPReward: 100,
+		reached: func(p Progress) bool { return p.StreakDays >= 30 }},
+	{ID: Streak100, Name: "Hundred Days", Description: "Read 100 days in a row", XPReward: 300,
+		reached: func(p Progress) bool { return p.StreakDays >= 100 }},
+	{ID: Minutes10k, Name: "Ten Thousand Minutes", Description: "Read 10,000 minutes in total", XPReward: 200,
+		reached: func(p Progress) bool { return p.TotalMinutes >= 10000 }},
+	{ID: FirstWin, Name: "Champion", Description: "Win a competition", XPReward: 100,
+		reached: func(p Progress) bool { return p.CompetitionWins > 0 }},
+	{ID: FirstGiftOut, Name: "Generous Reader", Description: "Give a gift after a competition", XPReward: 0,
+		reached: func(p Progress) bool { return p.GiftsGiven > 0 }},
+}
+
+// Catalog returns every achievement in display order.
+func Catalog() []Definition {
+	return append([]Definition(nil), catalog...)
+}
+
+// Find looks up a definition by id.
+func Find(id string) (Definition, bool) {
+	for _, d := range catalog {
+		if d.ID == id {
+			return d, true
+		}
+	}
+	return Definition{}, false
+}
+
+// Unlock records when a user earned an achievement.
+type Unlock struct {
+	UserID        string
+	AchievementID string
+	UnlockedAt    time.Time
+}
+
+// Reached returns the definitions p meets that are not in unlocked yet.
+func Reached(p Progress, unlocked map[string]bool) []Definition {
+	var out []Definition
+	for _, d := range catalog {
+		if !unlocked[d.ID] && d.Reached(p) {
+			out = append(out, d)
+		}
+	}
+	return out
+}
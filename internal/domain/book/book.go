@@ -0,0 +1,54 @@
+package book
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Book — catalog entry that reading sessions can reference
+type Book struct {
+	ID        string
+	Title     string
+	Author    string
+	ISBN      string
+	PageCount int
+	CoverURL  string
+	CreatedAt time.Time
+}
+
+func NewBook(title, author, isbn string, pageCount int, coverURL string) (*Book, error) {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return nil, errors.New("book title is required")
+	}
+	if pageCount < 0 {
+		return nil, errors.New("page count cannot be negative")
+	}
+
+	return &Book{
+		ID:        uuid.New().String(),
+		Title:     title,
+		Author:    strings.TrimSpace(author),
+		ISBN:      NormalizeISBN(isbn),
+		PageCount: pageCount,
+		CoverURL:  strings.TrimSpace(coverURL),
+		CreatedAt: time.Now().UTC(),
+	}, nil
+}
+
+// NormalizeISBN strips hyphens and spaces so "978-0-13-468599-1" and
+// "9780134685991" are treated as the same book.
+func NormalizeISBN(isbn string) string {
+	isbn = strings.ReplaceAll(isbn, "-", "")
+	isbn = strings.ReplaceAll(isbn, " ", "")
+	return strings.ToUpper(isbn)
+}
+
+// ValidISBN accepts an empty ISBN (unknown) or a 10/13 character one.
+func ValidISBN(isbn string) bool {
+	n := len(NormalizeISBN(isbn))
+	return n == 0 || n == 10 || n == 13
+}
@@ -0,0 +1,40 @@
+package calendar
+
+import "time"
+
+// Day returns the calendar date of t as seen in loc.
+// The result is midnight UTC carrying the local year/month/day, which is how
+// calendar dates are stored (DATE columns) and compared across the domain.
+func Day(t time.Time, loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.UTC
+	}
+	lt := t.In(loc)
+	return time.Date(lt.Year(), lt.Month(), lt.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// Bounds returns the real instants [start, end) of the local calendar day
+// containing t. Unlike Day, these are absolute times suitable for range
+// queries and account for DST days that are 23 or 25 hours long.
+func Bounds(t time.Time, loc *time.Location) (start, end time.Time) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	lt := t.In(loc)
+	start = time.Date(lt.Year(), lt.Month(), lt.Day(), 0, 0, 0, 0, loc)
+	end = start.AddDate(0, 0, 1)
+	return start.UTC(), end.UTC()
+}
+
+// LoadLocation resolves an IANA timezone name, falling back to UTC for an
+// empty or unknown name.
+func LoadLocation(name string) *time.Location {
+	if name == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
@@ -0,0 +1,157 @@
+package competition
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"strings"
+	"time"
+)
+
+// Visibility controls who can discover and join a competition.
+//   - public: listed for everyone, anyone can join
+//   - unlisted: hidden from listings, anyone with the link (id) can join
+//   - private: hidden, joining needs the invite code
+type Visibility string
+
+const (
+	VisibilityPublic   Visibility = "public"
+	VisibilityUnlisted Visibility = "unlisted"
+	VisibilityPrivate  Visibility = "private"
+)
+
+const inviteCodeLength = 8
+
+// SetVisibility switches who can see the competition; empty means public.
+// Non-public competitions always get an invite code so they can be shared.
+func (c *Competition) SetVisibility(v Visibility) error {
+	switch v {
+	case "", VisibilityPublic:
+		c.Visibility = VisibilityPublic
+	case VisibilityUnlisted, VisibilityPrivate:
+		c.Visibility = v
+	default:
+		return errors.New("visibility must be 'public', 'unlisted' or 'private'")
+	}
+
+	if c.Visibility != VisibilityPublic && c.InviteCode == "" {
+		return c.RegenerateInviteCode()
+	}
+	return nil
+}
+
+// RegenerateInviteCode replaces the invite code, invalidating old links.
+func (c *Competition) RegenerateInviteCode() error {
+	code, err := newInviteCode()
+	if err != nil {
+		return err
+	}
+	c.InviteCode = code
+	return nil
+}
+
+func (c *Competition) SetMaxParticipants(n int) error {
+	if n < 0 {
+		return errors.New("max_participants cannot be negative")
+	}
+	if n > 0 && n < len(c.Participants) {
+		return errors.New("max_participants is below the current participant count")
+	}
+	c.MaxParticipants = n
+	return nil
+}
+
+func (c *Competition) IsListed() bool {
+	return c.Visibility == "" || c.Visibility == VisibilityPublic
+}
+
+// IsFull reports whether the participant limit is reached; 0 means no limit.
+func (c *Competition) IsFull() bool {
+	return c.MaxParticipants > 0 && len(c.Participants) >= c.MaxParticipants
+}
+
+func (c *Competition) IsOwner(userID string) bool {
+	return userID != "" && c.OwnerID == userID
+}
+
+// VisibleTo tells whether the user may look at a competition fetched by id.
+// Private competitions are only shown to their organizers and participants.
+func (c *Competition) VisibleTo(userID string) bool {
+	if c.Visibility != VisibilityPrivate {
+		return true
+	}
+	if c.IsOrganizer(userID) {
+		return true
+	}
+	_, joined := c.Participants[userID]
+	return joined
+}
+
+// CheckInvite validates the invite code needed to enter a private competition.
+func (c *Competition) CheckInvite(userID, code string) error {
+	if c.Visibility != VisibilityPrivate || c.IsOrganizer(userID) {
+		return nil
+	}
+	if code == "" {
+		return errors.New("invite code is required for private competitions")
+	}
+	if !strings.EqualFold(strings.TrimSpace(code), c.InviteCode) {
+		return errors.New("invalid invite code")
+	}
+	return nil
+}
+
+// NeedsApproval tells whether joining creates a request instead of a participant.
+func (c *Competition) NeedsApproval(userID string) bool {
+	return c.RequiresApproval && !c.IsOrganizer(userID)
+}
+
+func newInviteCode() (string, error) {
+	buf := make([]byte, 5)
+	if _, err := rand.Read(buf); err != nil {
+		return "", errors.New("failed to generate invite code")
+	}
+	return base32.StdEncoding.EncodeToString(buf)[:inviteCodeLength], nil
+}
+
+type JoinRequestStatus string
+
+const (
+	JoinPending  JoinRequestStatus = "pending"
+	JoinApproved JoinRequestStatus = "approved"
+	JoinRejected JoinRequestStatus = "rejected"
+)
+
+// JoinRequest is a pending entry into a competition that requires organizer approval.
+type JoinRequest struct {
+	CompetitionID string
+	UserID        string
+	TeamID        string
+	Status        JoinRequestStatus
+	CreatedAt     time.Time
+	DecidedAt     *time.Time
+}
+
+func NewJoinRequest(competitionID, userID, teamID string) *JoinRequest {
+	return &JoinRequest{
+		CompetitionID: competitionID,
+		UserID:        userID,
+		TeamID:        teamID,
+		Status:        JoinPending,
+		CreatedAt:     time.Now().UTC(),
+	}
+}
+
+func (r *JoinRequest) Decide(approve bool) error {
+	if r.Status != JoinPending {
+		return errors.New("join request already decided")
+	}
+	now := time.Now().UTC()
+	r.DecidedAt = &now
+	if approve {
+		r.Status = JoinApproved
+	} else {
+		r.Status = JoinRejected
+	}
+	return nil
+}
@@ -4,24 +4,41 @@ import (
 	"errors"
 	"time"
 
+	"github.com/bakhtybayevn/powerbook/internal/domain/calendar"
 	"github.com/google/uuid"
 )
 
 type Status string
 
 const (
-	StatusOpen   Status = "open"
-	StatusClosed Status = "closed"
+	StatusOpen      Status = "open"
+	StatusClosed    Status = "closed"
+	StatusCancelled Status = "cancelled" // ended early by organizers, no XP awarded
 )
 
 type Competition struct {
-	ID           string
-	Name         string
-	StartDate    time.Time
-	EndDate      time.Time
-	Rules        Rules
-	Status       Status
+	ID        string
+	Name      string
+	StartDate time.Time
+	EndDate   time.Time
+	Rules     Rules
+	Status    Status
+	Format    Format
+	OwnerID   string
+
+	Organizers []string // co-organizers appointed by the owner
+	SeriesID   string   // set on instances created from a recurring series
+
+	XPPolicyVersion int // XP policy the competition was closed under; 0 until closed
+
+	// access control
+	Visibility       Visibility
+	InviteCode       string
+	RequiresApproval bool
+	MaxParticipants  int // 0 = unlimited
+
 	Participants map[string]*Participant // key: userID
+	Teams        map[string]*Team        // key: teamID; empty for individual competitions
 }
 
 func NewCompetition(name string, start, end time.Time, rules Rules) (*Competition, error) {
@@ -36,13 +53,42 @@ func NewCompetition(name string, start, end time.Time, rules Rules) (*Competitio
 		EndDate:      end.UTC(),
 		Rules:        rules,
 		Status:       StatusOpen,
+		Format:       FormatIndividual,
+		Visibility:   VisibilityPublic,
 		Participants: make(map[string]*Participant),
+		Teams:        make(map[string]*Team),
 	}, nil
 }
 
+// SetFormat switches between individual and team play; empty means individual.
+func (c *Competition) SetFormat(f Format) error {
+	switch f {
+	case "", FormatIndividual:
+		c.Format = FormatIndividual
+	case FormatTeam:
+		c.Format = FormatTeam
+	default:
+		return errors.New("format must be 'individual' or 'team'")
+	}
+	return nil
+}
+
+func (c *Competition) IsTeamCompetition() bool {
+	return c.Format == FormatTeam
+}
+
 func (c *Competition) IsActive(date time.Time) bool {
 	t := date.UTC()
 	return c.Status == StatusOpen &&
 		!t.Before(c.StartDate) &&
 		!t.After(c.EndDate)
 }
+
+// Counts reports whether a session at ts scores for p: it has to fall inside
+// the competition and on or after the day p joined, in the reader's timezone.
+func (c *Competition) Counts(p *Participant, ts time.Time, loc *time.Location) bool {
+	if ts.Before(c.StartDate) || ts.After(c.EndDate) {
+		return false
+	}
+	return !calendar.Day(ts, loc).Before(calendar.Day(p.JoinedAt, loc))
+}
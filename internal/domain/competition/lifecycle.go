@@ -0,0 +1,76 @@
+package competition
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+func (c *Competition) IsOpen() bool {
+	return c.Status == StatusOpen
+}
+
+func (c *Competition) HasStarted(now time.Time) bool {
+	return !now.UTC().Before(c.StartDate)
+}
+
+func (c *Competition) Rename(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return errors.New("competition name is required")
+	}
+	c.Name = name
+	return nil
+}
+
+// Reschedule moves the competition window. The start can only move before
+// the competition begins; the end can move while it is open, but not into
+// the past, which would let the scheduler close it at once.
+func (c *Competition) Reschedule(start, end, now time.Time) error {
+	if !c.IsOpen() {
+		return errors.New("only open competitions can be rescheduled")
+	}
+
+	start, end, now = start.UTC(), end.UTC(), now.UTC()
+	if !start.Equal(c.StartDate) && c.HasStarted(now) {
+		return errors.New("start date cannot change after the competition started")
+	}
+	if end.Before(start) {
+		return errors.New("competition end date cannot be before start date")
+	}
+	if !end.Equal(c.EndDate) && end.Before(now) {
+		return errors.New("end date cannot be moved into the past")
+	}
+
+	c.StartDate = start
+	c.EndDate = end
+	return nil
+}
+
+// ChangeRules swaps the scoring rules; only allowed before the start, so
+// nobody's points were earned under different rules.
+func (c *Competition) ChangeRules(rules Rules, now time.Time) error {
+	if !c.IsOpen() {
+		return errors.New("only open competitions can change rules")
+	}
+	if c.HasStarted(now) {
+		return errors.New("rules cannot change after the competition started")
+	}
+	if err := rules.Validate(); err != nil {
+		return err
+	}
+	c.Rules = rules
+	return nil
+}
+
+// Cancel ends an open competition without ranking it or awarding XP.
+func (c *Competition) Cancel() error {
+	switch c.Status {
+	case StatusClosed:
+		return errors.New("competition already closed")
+	case StatusCancelled:
+		return errors.New("competition already cancelled")
+	}
+	c.Status = StatusCancelled
+	return nil
+}
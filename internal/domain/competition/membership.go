@@ -0,0 +1,25 @@
+package competition
+
+import "errors"
+
+// RemoveParticipant takes a member out of the competition. When the member
+// captained a team, the team is returned with its captain cleared so the
+// caller can persist it.
+func (c *Competition) RemoveParticipant(userID string) (*Participant, *Team, error) {
+	if !c.IsOpen() {
+		return nil, nil, errors.New("competition is " + string(c.Status))
+	}
+
+	p, ok := c.Participants[userID]
+	if !ok {
+		return nil, nil, errors.New("user is not a participant")
+	}
+	delete(c.Participants, userID)
+
+	var captained *Team
+	if t, ok := c.Teams[p.TeamID]; ok && t.CaptainID == userID {
+		t.CaptainID = ""
+		captained = t
+	}
+	return p, captained, nil
+}
@@ -0,0 +1,44 @@
+package competition
+
+import "errors"
+
+// IsOrganizer reports whether the user runs the competition: the owner or
+// one of the co-organizers the owner appointed.
+func (c *Competition) IsOrganizer(userID string) bool {
+	if c.IsOwner(userID) {
+		return true
+	}
+	if userID == "" {
+		return false
+	}
+	for _, id := range c.Organizers {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Competition) AddOrganizer(userID string) error {
+	if userID == "" {
+		return errors.New("user id is required")
+	}
+	if c.IsOrganizer(userID) {
+		return errors.New("user is already an organizer")
+	}
+	c.Organizers = append(c.Organizers, userID)
+	return nil
+}
+
+func (c *Competition) RemoveOrganizer(userID string) error {
+	if c.IsOwner(userID) {
+		return errors.New("the owner cannot be removed as organizer")
+	}
+	for i, id := range c.Organizers {
+		if id == userID {
+			c.Organizers = append(c.Organizers[:i], c.Organizers[i+1:]...)
+			return nil
+		}
+	}
+	return errors.New("user is not an organizer")
+}
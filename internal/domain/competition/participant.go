@@ -1,13 +1,37 @@
 package competition
 
-import "time"
+import (
+	"sort"
+	"time"
+
+	"github.com/bakhtybayevn/powerbook/internal/domain/calendar"
+)
 
 type Participant struct {
 	UserID       string
+	TeamID       string // empty in individual competitions
 	Points       int
 	DaysRead     int
 	LastLogDate  *time.Time
 	MinutesTotal int
+
+	// extra stats used by non-minute scoring rules
+	PagesTotal    int
+	BooksFinished int
+	StreakCurrent int // consecutive days read ending at LastLogDate
+	StreakLongest int
+	DayMinutes    int // minutes logged on LastLogDate
+	CappedMinutes int // minutes counted under the daily cap
+
+	JoinedAt time.Time
+}
+
+// ReadingSession is the part of a reading log that competitions score.
+type ReadingSession struct {
+	Minutes      int
+	PagesRead    int
+	FinishedBook bool
+	Timestamp    time.Time
 }
 
 func NewParticipant(userID string) *Participant {
@@ -16,25 +40,89 @@ func NewParticipant(userID string) *Participant {
 		Points:       0,
 		DaysRead:     0,
 		MinutesTotal: 0,
+		JoinedAt:     time.Now().UTC(),
 	}
 }
 
-func (p *Participant) AddReading(minutes int, timestamp time.Time, rules Rules) {
-	pointsEarned := rules.PointsPerMinute * minutes
-	p.Points += pointsEarned
+// AddReading applies a reading session and returns the points it earned
+// under the competition's rules. loc is the reader's timezone and decides
+// which calendar day the session counts towards. dayMinutes is what the
+// reader had already logged towards this competition on that day, taken from
+// the reading logs so backdated sessions are capped like any other. readDays
+// are the days (in loc) the reader already has a counted log on; streaks are
+// worked out from them and the session's day, so a backdated session scores
+// the same whether it is logged live or replayed in date order.
+func (p *Participant) AddReading(s ReadingSession, dayMinutes int, readDays []time.Time, rules Rules, loc *time.Location) int {
+	p.MinutesTotal += s.Minutes
+	p.PagesTotal += s.PagesRead
+	if s.FinishedBook {
+		p.BooksFinished++
+	}
 
-	p.MinutesTotal += minutes
+	// the first session of a day counts the day, whenever it is logged
+	if dayMinutes == 0 {
+		p.DaysRead++
+	}
 
-	// streak inside competition
-	day := timestamp.UTC().Truncate(24 * time.Hour)
+	day := calendar.Day(s.Timestamp, loc)
+	if p.LastLogDate == nil || day.After(calendar.Day(*p.LastLogDate, time.UTC)) {
+		p.DayMinutes = 0
+		p.LastLogDate = &day
+	}
+	if day.Equal(calendar.Day(*p.LastLogDate, time.UTC)) {
+		p.DayMinutes += s.Minutes
+	}
+	p.StreakCurrent, p.StreakLongest = streaks(readDays, day)
 
-	if p.LastLogDate == nil {
-		p.DaysRead = 1
-	} else {
-		prevDay := p.LastLogDate.UTC().Truncate(24 * time.Hour)
-		if day.After(prevDay) {
-			p.DaysRead++
+	// minutes that still fit under the daily cap
+	if rules.DailyMinuteCap > 0 {
+		room := rules.DailyMinuteCap - dayMinutes
+		if room > s.Minutes {
+			room = s.Minutes
+		}
+		if room > 0 {
+			p.CappedMinutes += room
 		}
 	}
-	p.LastLogDate = &day
+
+	before := p.Points
+	p.Points = rules.Scorer().Points(p)
+	return p.Points - before
+}
+
+// streaks returns the run of consecutive days ending at the latest of
+// readDays and day, and the longest run among them. Days may repeat and come
+// in any order.
+func streaks(readDays []time.Time, day time.Time) (current, longest int) {
+	sorted := append(append(make([]time.Time, 0, len(readDays)+1), readDays...), day)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Before(sorted[j]) })
+
+	for i, d := range sorted {
+		switch {
+		case i > 0 && d.Equal(sorted[i-1]):
+			continue
+		case i > 0 && sorted[i-1].AddDate(0, 0, 1).Equal(d):
+			current++
+		default:
+			current = 1
+		}
+		if current > longest {
+			longest = current
+		}
+	}
+	return current, longest
+}
+
+// Reset clears accumulated stats so they can be replayed from reading logs.
+func (p *Participant) Reset() {
+	p.Points = 0
+	p.DaysRead = 0
+	p.MinutesTotal = 0
+	p.LastLogDate = nil
+	p.PagesTotal = 0
+	p.BooksFinished = 0
+	p.StreakCurrent = 0
+	p.StreakLongest = 0
+	p.DayMinutes = 0
+	p.CappedMinutes = 0
 }
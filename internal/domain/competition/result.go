@@ -0,0 +1,27 @@
+package competition
+
+// Result is one row of a closed competition's final standings, frozen when
+// the competition closes so later changes to participants don't alter it.
+type Result struct {
+	UserID       string
+	Rank         int
+	Points       int
+	DaysRead     int
+	MinutesTotal int
+	XPEarned     int
+	Group        string // gift group: "top", "bottom" or "neutral"
+
+	// team competitions only
+	TeamID   string
+	TeamName string
+	TeamRank int
+}
+
+// Won reports whether the row took first place; in team competitions the
+// whole winning team counts as winners.
+func (r Result) Won() bool {
+	if r.TeamID != "" {
+		return r.TeamRank == 1
+	}
+	return r.Rank == 1
+}
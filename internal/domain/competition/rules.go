@@ -1,5 +1,100 @@
 package competition
 
+import (
+	"errors"
+	"fmt"
+)
+
+// ScoringType selects how a competition turns reading into points.
+type ScoringType string
+
+const (
+	ScoringMinutes       ScoringType = "minutes"        // points_per_minute × minutes read
+	ScoringCappedMinutes ScoringType = "capped_minutes" // like minutes, but only daily_minute_cap minutes count per day
+	ScoringDaysRead      ScoringType = "days_read"      // points_per_unit × distinct days with a log
+	ScoringPagesRead     ScoringType = "pages_read"     // points_per_unit × pages read
+	ScoringBooksFinished ScoringType = "books_finished" // points_per_unit × books finished
+	ScoringLongestStreak ScoringType = "longest_streak" // points_per_unit × longest run of consecutive days
+)
+
+// Rules is the scoring configuration stored with a competition.
 type Rules struct {
-	PointsPerMinute int
+	Type            ScoringType
+	PointsPerMinute int // minutes, capped_minutes
+	PointsPerUnit   int // days_read, pages_read, books_finished, longest_streak
+	DailyMinuteCap  int // capped_minutes
+}
+
+// Scorer is a scoring strategy: it derives a participant's points from the
+// stats accumulated by Participant.AddReading.
+type Scorer interface {
+	Points(p *Participant) int
+}
+
+type ScorerFunc func(p *Participant) int
+
+func (f ScorerFunc) Points(p *Participant) int { return f(p) }
+
+// ScoringType returns the rule type, treating the zero value as minutes so
+// competitions created before typed rules keep scoring the same way.
+func (r Rules) ScoringType() ScoringType {
+	if r.Type == "" {
+		return ScoringMinutes
+	}
+	return r.Type
+}
+
+// Scorer returns the strategy for these rules.
+func (r Rules) Scorer() Scorer {
+	switch r.ScoringType() {
+	case ScoringCappedMinutes:
+		return ScorerFunc(func(p *Participant) int { return r.PointsPerMinute * p.CappedMinutes })
+	case ScoringDaysRead:
+		return ScorerFunc(func(p *Participant) int { return r.PointsPerUnit * p.DaysRead })
+	case ScoringPagesRead:
+		return ScorerFunc(func(p *Participant) int { return r.PointsPerUnit * p.PagesTotal })
+	case ScoringBooksFinished:
+		return ScorerFunc(func(p *Participant) int { return r.PointsPerUnit * p.BooksFinished })
+	case ScoringLongestStreak:
+		return ScorerFunc(func(p *Participant) int { return r.PointsPerUnit * p.StreakLongest })
+	default:
+		return ScorerFunc(func(p *Participant) int { return r.PointsPerMinute * p.MinutesTotal })
+	}
+}
+
+// Ranks reports whether a should be ranked above b: more points first, then
+// minutes read as a tie-breaker (days read for minute-based rules).
+func (r Rules) Ranks(a, b *Participant) bool {
+	if a.Points != b.Points {
+		return a.Points > b.Points
+	}
+	switch r.ScoringType() {
+	case ScoringMinutes, ScoringCappedMinutes:
+		return a.DaysRead > b.DaysRead
+	default:
+		return a.MinutesTotal > b.MinutesTotal
+	}
+}
+
+func (r Rules) Validate() error {
+	switch r.ScoringType() {
+	case ScoringMinutes:
+		if r.PointsPerMinute <= 0 {
+			return errors.New("points_per_minute must be > 0")
+		}
+	case ScoringCappedMinutes:
+		if r.PointsPerMinute <= 0 {
+			return errors.New("points_per_minute must be > 0")
+		}
+		if r.DailyMinuteCap <= 0 || r.DailyMinuteCap > 1440 {
+			return errors.New("daily_minute_cap must be between 1 and 1440")
+		}
+	case ScoringDaysRead, ScoringPagesRead, ScoringBooksFinished, ScoringLongestStreak:
+		if r.PointsPerUnit <= 0 {
+			return errors.New("points_per_unit must be > 0")
+		}
+	default:
+		return fmt.Errorf("unknown scoring type %q", r.Type)
+	}
+	return nil
 }
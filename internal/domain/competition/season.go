@@ -0,0 +1,174 @@
+package competition
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SeasonStatus string
+
+const (
+	SeasonOpen   SeasonStatus = "open"
+	SeasonClosed SeasonStatus = "closed"
+)
+
+// Season adds up the results of a series' competitions that start inside
+// its window.
+type Season struct {
+	ID        string
+	SeriesID  string
+	Name      string
+	StartDate time.Time
+	EndDate   time.Time
+	Status    SeasonStatus
+	CreatedAt time.Time
+
+	XPPolicyVersion int // XP policy the season was closed under; 0 until closed
+}
+
+// SeasonStanding is one row of the season table.
+type SeasonStanding struct {
+	UserID       string
+	Points       int
+	Competitions int
+	Wins         int
+	Rank         int
+	XPEarned     int
+}
+
+func NewSeason(seriesID, name string, start, end time.Time) (*Season, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, errors.New("season name is required")
+	}
+	if !end.After(start) {
+		return nil, errors.New("season end date must be after start date")
+	}
+
+	return &Season{
+		ID:        uuid.New().String(),
+		SeriesID:  seriesID,
+		Name:      name,
+		StartDate: start.UTC(),
+		EndDate:   end.UTC(),
+		Status:    SeasonOpen,
+		CreatedAt: time.Now().UTC(),
+	}, nil
+}
+
+// Includes reports whether a competition counts towards the season.
+func (s *Season) Includes(c *Competition) bool {
+	return c.SeriesID == s.SeriesID &&
+		c.Status != StatusCancelled &&
+		!c.StartDate.Before(s.StartDate) &&
+		!c.StartDate.After(s.EndDate)
+}
+
+// ReadyToClose reports whether the window has passed and every competition
+// in it is finished, so the table can no longer change.
+func (s *Season) ReadyToClose(comps []*Competition, now time.Time) bool {
+	if s.Status != SeasonOpen || now.UTC().Before(s.EndDate) {
+		return false
+	}
+	for _, c := range comps {
+		if s.Includes(c) && c.IsOpen() {
+			return false
+		}
+	}
+	return true
+}
+
+// Standings sums points across the season's competitions. Closed
+// competitions count with their final standings from results (keyed by
+// competition id), so team placements and the close's tie-breaks carry
+// over; open ones count with live points and no wins yet. Ties are broken by
+// competition wins, then by the number of competitions played.
+func (s *Season) Standings(comps []*Competition, results map[string][]Result) []SeasonStanding {
+	rows := map[string]*SeasonStanding{}
+
+	for _, c := range comps {
+		if !s.Includes(c) {
+			continue
+		}
+
+		final, ok := results[c.ID]
+		if !ok {
+			final = provisionalResults(c)
+		}
+		for _, r := range final {
+			row, ok := rows[r.UserID]
+			if !ok {
+				row = &SeasonStanding{UserID: r.UserID}
+				rows[r.UserID] = row
+			}
+			row.Points += r.Points
+			row.Competitions++
+
+			if !c.IsOpen() && r.Won() && (r.TeamID != "" || r.Points > 0) {
+				row.Wins++
+			}
+		}
+	}
+
+	out := make([]SeasonStanding, 0, len(rows))
+	for _, r := range rows {
+		out = append(out, *r)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		a, b := out[i], out[j]
+		if a.Points != b.Points {
+			return a.Points > b.Points
+		}
+		if a.Wins != b.Wins {
+			return a.Wins > b.Wins
+		}
+		if a.Competitions != b.Competitions {
+			return a.Competitions > b.Competitions
+		}
+		return a.UserID < b.UserID
+	})
+	for i := range out {
+		out[i].Rank = i + 1
+	}
+	return out
+}
+
+// provisionalResults ranks the live participants the way a close would, for
+// open competitions and ones closed before final standings were stored.
+// Full ties go to the lower user id so the order never changes between calls.
+func provisionalResults(c *Competition) []Result {
+	ranked := make([]*Participant, 0, len(c.Participants))
+	for _, p := range c.Participants {
+		ranked = append(ranked, p)
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if c.Rules.Ranks(ranked[i], ranked[j]) {
+			return true
+		}
+		if c.Rules.Ranks(ranked[j], ranked[i]) {
+			return false
+		}
+		return ranked[i].UserID < ranked[j].UserID
+	})
+
+	teamRank := map[string]int{}
+	if c.IsTeamCompetition() {
+		for i, t := range c.TeamStandings() {
+			teamRank[t.Team.ID] = i + 1
+		}
+	}
+
+	out := make([]Result, len(ranked))
+	for i, p := range ranked {
+		out[i] = Result{UserID: p.UserID, Rank: i + 1, Points: p.Points, DaysRead: p.DaysRead, MinutesTotal: p.MinutesTotal}
+		if rank, ok := teamRank[p.TeamID]; ok {
+			out[i].TeamID = p.TeamID
+			out[i].TeamRank = rank
+		}
+	}
+	return out
+}
@@ -0,0 +1,142 @@
+package competition
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Recurrence is how often a series starts a new competition.
+type Recurrence string
+
+const (
+	RecurWeekly  Recurrence = "weekly"
+	RecurMonthly Recurrence = "monthly"
+)
+
+// Series is a competition template that the scheduler instantiates once per
+// period ("Reading Sprint — January 2026", "— February 2026", ...).
+type Series struct {
+	ID         string
+	Name       string
+	OwnerID    string
+	Recurrence Recurrence
+
+	// template copied onto every instance
+	Rules            Rules
+	Format           Format
+	Visibility       Visibility
+	RequiresApproval bool
+	MaxParticipants  int
+
+	Anchor    time.Time // start of the first instance; every later one is counted from it
+	NextStart time.Time // start of the next instance to create
+	Active    bool
+	CreatedAt time.Time
+}
+
+func NewSeries(name, ownerID string, rec Recurrence, firstStart time.Time, rules Rules) (*Series, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, errors.New("series name is required")
+	}
+	if rec != RecurWeekly && rec != RecurMonthly {
+		return nil, errors.New("recurrence must be 'weekly' or 'monthly'")
+	}
+	if err := rules.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &Series{
+		ID:         uuid.New().String(),
+		Name:       name,
+		OwnerID:    ownerID,
+		Recurrence: rec,
+		Rules:      rules,
+		Format:     FormatIndividual,
+		Visibility: VisibilityPublic,
+		Anchor:     firstStart.UTC(),
+		NextStart:  firstStart.UTC(),
+		Active:     true,
+		CreatedAt:  time.Now().UTC(),
+	}, nil
+}
+
+func (s *Series) nextAfter(start time.Time) time.Time {
+	return s.instanceStart(s.instanceIndex(start) + 1)
+}
+
+// instanceStart is the start of the nth instance. Months are counted from
+// the anchor rather than from the previous instance, so a series anchored on
+// the 31st runs on the last day of shorter months and comes back to the 31st.
+func (s *Series) instanceStart(n int) time.Time {
+	a := s.Anchor
+	if s.Recurrence == RecurWeekly {
+		return a.AddDate(0, 0, 7*n)
+	}
+	first := time.Date(a.Year(), a.Month()+time.Month(n), 1, a.Hour(), a.Minute(), a.Second(), a.Nanosecond(), a.Location())
+	day := a.Day()
+	if last := first.AddDate(0, 1, -1).Day(); day > last {
+		day = last
+	}
+	return first.AddDate(0, 0, day-1)
+}
+
+// instanceIndex tells which instance starts at start.
+func (s *Series) instanceIndex(start time.Time) int {
+	if s.Recurrence == RecurWeekly {
+		return int(start.Sub(s.Anchor) / (7 * 24 * time.Hour))
+	}
+	return (start.Year()-s.Anchor.Year())*12 + int(start.Month()) - int(s.Anchor.Month())
+}
+
+// PeriodEnd is the last second of the instance starting at start.
+func (s *Series) PeriodEnd(start time.Time) time.Time {
+	return s.nextAfter(start).Add(-time.Second)
+}
+
+func (s *Series) InstanceName(start time.Time) string {
+	if s.Recurrence == RecurWeekly {
+		return fmt.Sprintf("%s — week of %s", s.Name, start.Format("Jan 2, 2006"))
+	}
+	return fmt.Sprintf("%s — %s", s.Name, start.Format("January 2006"))
+}
+
+// Due reports whether the next instance should be created now; instances
+// open `lead` ahead of their start so people can join in advance.
+func (s *Series) Due(now time.Time, lead time.Duration) bool {
+	return s.Active && !now.UTC().Before(s.NextStart.Add(-lead))
+}
+
+// SkipMissed moves past periods that already ended, e.g. after downtime,
+// so the scheduler doesn't create competitions nobody could play.
+func (s *Series) SkipMissed(now time.Time) {
+	for s.PeriodEnd(s.NextStart).Before(now.UTC()) {
+		s.NextStart = s.nextAfter(s.NextStart)
+	}
+}
+
+// Spawn builds the next instance from the template and advances the series.
+func (s *Series) Spawn() (*Competition, error) {
+	start := s.NextStart
+	cmp, err := NewCompetition(s.InstanceName(start), start, s.PeriodEnd(start), s.Rules)
+	if err != nil {
+		return nil, err
+	}
+	if err := cmp.SetFormat(s.Format); err != nil {
+		return nil, err
+	}
+	if err := cmp.SetVisibility(s.Visibility); err != nil {
+		return nil, err
+	}
+	cmp.OwnerID = s.OwnerID
+	cmp.RequiresApproval = s.RequiresApproval
+	cmp.MaxParticipants = s.MaxParticipants
+	cmp.SeriesID = s.ID
+
+	s.NextStart = s.nextAfter(start)
+	return cmp, nil
+}
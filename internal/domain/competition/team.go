@@ -0,0 +1,108 @@
+package competition
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Format decides whether participants compete alone or as teams.
+type Format string
+
+const (
+	FormatIndividual Format = "individual"
+	FormatTeam       Format = "team"
+)
+
+// Team groups participants inside a team competition.
+type Team struct {
+	ID            string
+	CompetitionID string
+	Name          string
+	CaptainID     string
+	CreatedAt     time.Time
+}
+
+func NewTeam(competitionID, name, captainID string) (*Team, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, errors.New("team name is required")
+	}
+	if len(name) > 64 {
+		return nil, errors.New("team name too long")
+	}
+
+	return &Team{
+		ID:            uuid.New().String(),
+		CompetitionID: competitionID,
+		Name:          name,
+		CaptainID:     captainID,
+		CreatedAt:     time.Now().UTC(),
+	}, nil
+}
+
+// TeamStanding is a team's aggregate result: the sum of its members' stats.
+type TeamStanding struct {
+	Team         *Team
+	Points       int
+	MinutesTotal int
+	Members      []*Participant
+}
+
+// TeamStandings sums member stats per team, best team first.
+func (c *Competition) TeamStandings() []TeamStanding {
+	byTeam := make(map[string]*TeamStanding, len(c.Teams))
+	for id, t := range c.Teams {
+		byTeam[id] = &TeamStanding{Team: t}
+	}
+
+	for _, p := range c.Participants {
+		s, ok := byTeam[p.TeamID]
+		if !ok {
+			continue
+		}
+		s.Points += p.Points
+		s.MinutesTotal += p.MinutesTotal
+		s.Members = append(s.Members, p)
+	}
+
+	out := make([]TeamStanding, 0, len(byTeam))
+	for _, s := range byTeam {
+		out = append(out, *s)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Points != out[j].Points {
+			return out[i].Points > out[j].Points
+		}
+		if out[i].MinutesTotal != out[j].MinutesTotal {
+			return out[i].MinutesTotal > out[j].MinutesTotal
+		}
+		return out[i].Team.Name < out[j].Team.Name
+	})
+	return out
+}
+
+// TeamPoints returns the summed points of a team's members.
+func (c *Competition) TeamPoints(teamID string) int {
+	total := 0
+	for _, p := range c.Participants {
+		if p.TeamID == teamID {
+			total += p.Points
+		}
+	}
+	return total
+}
+
+// TeamNameTaken reports whether another team in this competition already uses name.
+func (c *Competition) TeamNameTaken(name string) bool {
+	name = strings.TrimSpace(name)
+	for _, t := range c.Teams {
+		if strings.EqualFold(t.Name, name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,99 @@
+package email
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Status string
+
+const (
+	StatusPending Status = "pending"
+	StatusSent    Status = "sent"
+	StatusFailed  Status = "failed" // gave up: rejected by the server or out of attempts
+)
+
+// MaxAttempts is how often a message is tried before it is given up on.
+const MaxAttempts = 10
+
+// Retry backoff doubles from the first delay up to the cap: 1m, 2m, 4m ... 1h.
+const (
+	firstRetryDelay = time.Minute
+	maxRetryDelay   = time.Hour
+)
+
+// Message is a rendered email.
+type Message struct {
+	To      string
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// OutboxMessage is a message waiting in the outbox, or the record of one
+// that was sent or given up on.
+type OutboxMessage struct {
+	ID string
+	Message
+
+	// Key makes a message go out at most once, e.g. one weekly summary per
+	// user and week. Empty for messages that are never deduplicated.
+	Key string
+
+	// Sensitive messages carry a secret such as a password reset token;
+	// their bodies are wiped once the message is sent or given up on.
+	Sensitive bool
+
+	Status        Status
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	CreatedAt     time.Time
+	SentAt        *time.Time
+}
+
+func NewOutboxMessage(m Message, key string, now time.Time) *OutboxMessage {
+	return &OutboxMessage{
+		ID:            uuid.New().String(),
+		Message:       m,
+		Key:           key,
+		Status:        StatusPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}
+}
+
+func (m *OutboxMessage) MarkSent(now time.Time) {
+	m.Attempts++
+	m.Status = StatusSent
+	m.SentAt = &now
+	m.LastError = ""
+	m.scrub()
+}
+
+// MarkFailed records a failed attempt and schedules the next one. Permanent
+// failures, and the last allowed attempt, give up on the message.
+func (m *OutboxMessage) MarkFailed(reason string, permanent bool, now time.Time) {
+	m.Attempts++
+	m.LastError = reason
+	if permanent || m.Attempts >= MaxAttempts {
+		m.Status = StatusFailed
+		m.scrub()
+		return
+	}
+
+	delay := firstRetryDelay << (m.Attempts - 1)
+	if delay > maxRetryDelay || delay <= 0 {
+		delay = maxRetryDelay
+	}
+	m.NextAttemptAt = now.Add(delay)
+}
+
+// scrub drops the bodies of a sensitive message that will not be sent again.
+func (m *OutboxMessage) scrub() {
+	if m.Sensitive {
+		m.Text = ""
+		m.HTML = ""
+	}
+}
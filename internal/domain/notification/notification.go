@@ -0,0 +1,95 @@
+package notification
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Kind says what a notification is about. Kinds are stored, so never rename one.
+type Kind string
+
+const (
+	KindCompetitionStarted Kind = "competition_started"
+	KindCompetitionResult  Kind = "competition_result"
+	KindGiftPairing        Kind = "gift_pairing"
+	KindGiftSent           Kind = "gift_sent"     // the giver confirmed sending
+	KindGiftReceived       Kind = "gift_received" // the receiver confirmed receiving
+	KindOvertaken          Kind = "overtaken"
+)
+
+// Channel is a way of reaching a user besides the in-app inbox.
+type Channel string
+
+const (
+	ChannelEmail    Channel = "email"
+	ChannelTelegram Channel = "telegram"
+)
+
+// Notification is one message in a user's inbox.
+type Notification struct {
+	ID            string
+	UserID        string
+	Kind          Kind
+	Title         string
+	Body          string
+	CompetitionID string // empty when not about a competition
+
+	// Key makes a notification go out at most once per user, e.g. for
+	// events a scheduler may see on several ticks. Empty for one-off events.
+	Key string
+
+	CreatedAt time.Time
+	ReadAt    *time.Time
+}
+
+func New(userID string, kind Kind, title, body string) *Notification {
+	return &Notification{
+		ID:        uuid.New().String(),
+		UserID:    userID,
+		Kind:      kind,
+		Title:     title,
+		Body:      body,
+		CreatedAt: time.Now().UTC(),
+	}
+}
+
+// About ties the notification to a competition.
+func (n *Notification) About(competitionID string) *Notification {
+	n.CompetitionID = competitionID
+	return n
+}
+
+// Once sets the key that keeps the notification from being sent twice.
+func (n *Notification) Once(key string) *Notification {
+	n.Key = key
+	return n
+}
+
+func (n *Notification) IsRead() bool {
+	return n.ReadAt != nil
+}
+
+// Preferences are the channels a user wants notifications on. The inbox
+// always keeps a copy, so it cannot be turned off.
+type Preferences struct {
+	UserID   string
+	Email    bool
+	Telegram bool
+}
+
+// DefaultPreferences apply until the user saves their own. Telegram only
+// reaches users who linked a chat.
+func DefaultPreferences(userID string) *Preferences {
+	return &Preferences{UserID: userID, Email: true, Telegram: true}
+}
+
+func (p *Preferences) Enabled(ch Channel) bool {
+	switch ch {
+	case ChannelEmail:
+		return p.Email
+	case ChannelTelegram:
+		return p.Telegram
+	}
+	return false
+}
@@ -13,6 +13,11 @@ type Reading struct {
 	Minutes   int       `json:"minutes"`
 	Source    string    `json:"source"`
 	Timestamp time.Time `json:"timestamp"`
+
+	// optional link to the book catalog; empty when the session is not tied to a book
+	BookID       string `json:"book_id,omitempty"`
+	PagesRead    int    `json:"pages_read"`
+	FinishedBook bool   `json:"finished_book"`
 }
 
 func NewReading(userID string, minutes int, source string, timestamp time.Time) *Reading {
@@ -24,3 +29,12 @@ func NewReading(userID string, minutes int, source string, timestamp time.Time)
 		Timestamp: timestamp,
 	}
 }
+
+// WithBook attaches the session to a catalog book. finished marks the
+// session in which the reader completed the book.
+func (r *Reading) WithBook(bookID string, pagesRead int, finished bool) *Reading {
+	r.BookID = bookID
+	r.PagesRead = pagesRead
+	r.FinishedBook = finished
+	return r
+}
@@ -0,0 +1,68 @@
+package user
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// HashPassword returns the hash stored for a new password. The repository
+// writes it together with a new session version, signing the user out
+// everywhere.
+func HashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", errors.New("failed to hash password")
+	}
+	return string(hash), nil
+}
+
+// PasswordResetTTL is how long a reset link works.
+const PasswordResetTTL = time.Hour
+
+// PasswordResetCooldown is how long after one reset email another request
+// is ignored, so nobody can flood an inbox or keep killing the live link.
+const PasswordResetCooldown = 5 * time.Minute
+
+// PasswordResetToken is a pending password reset. Only the SHA-256 of the
+// token is stored; the token itself exists only in the email sent to the user.
+type PasswordResetToken struct {
+	TokenHash string
+	UserID    string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+// NewPasswordResetToken returns the token to send to the user and the
+// record to store.
+func NewPasswordResetToken(userID string, now time.Time) (string, *PasswordResetToken, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", nil, errors.New("failed to generate reset token")
+	}
+	token := hex.EncodeToString(buf)
+	return token, &PasswordResetToken{
+		TokenHash: HashPasswordResetToken(token),
+		UserID:    userID,
+		ExpiresAt: now.Add(PasswordResetTTL),
+		CreatedAt: now,
+	}, nil
+}
+
+func HashPasswordResetToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func (t *PasswordResetToken) Expired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+// CoolingDown reports whether the reset is too recent for another one.
+func (t *PasswordResetToken) CoolingDown(now time.Time) bool {
+	return now.Before(t.CreatedAt.Add(PasswordResetCooldown))
+}
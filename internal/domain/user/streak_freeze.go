@@ -0,0 +1,108 @@
+package user
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxStreakFreezes is how many unused freezes a user can hold at once.
+	MaxStreakFreezes = 3
+	// StreakFreezeEarnEvery grants one freeze each time the streak reaches a multiple of this many days.
+	StreakFreezeEarnEvery = 7
+	// StreakFreezeXPCost is the price of buying a freeze.
+	StreakFreezeXPCost = 100
+)
+
+type StreakFreezeEventKind string
+
+const (
+	FreezeEarned    StreakFreezeEventKind = "earned"
+	FreezePurchased StreakFreezeEventKind = "purchased"
+	FreezeUsed      StreakFreezeEventKind = "used"
+	FreezeRewarded  StreakFreezeEventKind = "rewarded" // level-up reward
+)
+
+// StreakFreezeEvent records a freeze being earned, bought or spent.
+type StreakFreezeEvent struct {
+	ID        string
+	UserID    string
+	Kind      StreakFreezeEventKind
+	Day       *time.Time // missed calendar day covered by the freeze (used only)
+	XPCost    int
+	CreatedAt time.Time
+}
+
+func newStreakFreezeEvent(userID string, kind StreakFreezeEventKind) *StreakFreezeEvent {
+	return &StreakFreezeEvent{
+		ID:        uuid.New().String(),
+		UserID:    userID,
+		Kind:      kind,
+		CreatedAt: time.Now().UTC(),
+	}
+}
+
+// BuyStreakFreeze exchanges XP for a freeze.
+func (u *User) BuyStreakFreeze() error {
+	if u.StreakFreezes >= MaxStreakFreezes {
+		return errors.New("streak freeze limit reached")
+	}
+	if u.XP < StreakFreezeXPCost {
+		return errors.New("not enough XP to buy a streak freeze")
+	}
+
+	u.AddXP(-StreakFreezeXPCost, XPStreakFreeze, XPSource{})
+	u.StreakFreezes++
+
+	e := newStreakFreezeEvent(u.ID, FreezePurchased)
+	e.XPCost = StreakFreezeXPCost
+	u.freezeEvents = append(u.freezeEvents, e)
+	return nil
+}
+
+// TakeFreezeEvents returns freeze events recorded since the last call and
+// clears them, so the application layer can persist each one exactly once.
+func (u *User) TakeFreezeEvents() []*StreakFreezeEvent {
+	events := u.freezeEvents
+	u.freezeEvents = nil
+	return events
+}
+
+// RewardStreakFreezes grants up to n freezes as a reward, within the usual
+// limit, and returns how many were granted.
+func (u *User) RewardStreakFreezes(n int) int {
+	granted := 0
+	for ; granted < n && u.StreakFreezes < MaxStreakFreezes; granted++ {
+		u.StreakFreezes++
+		u.freezeEvents = append(u.freezeEvents, newStreakFreezeEvent(u.ID, FreezeRewarded))
+	}
+	return granted
+}
+
+func (u *User) earnStreakFreeze() {
+	if u.StreakFreezes >= MaxStreakFreezes {
+		return
+	}
+	u.StreakFreezes++
+	u.freezeEvents = append(u.freezeEvents, newStreakFreezeEvent(u.ID, FreezeEarned))
+}
+
+// bridgeMissedDay decides whether a single missed day keeps the streak alive.
+// Live logs spend a freeze if one is available; replays only honour days that
+// were already frozen.
+func (u *User) bridgeMissedDay(missed time.Time, frozenDays map[time.Time]bool) bool {
+	if frozenDays != nil {
+		return frozenDays[missed]
+	}
+	if u.StreakFreezes <= 0 {
+		return false
+	}
+
+	u.StreakFreezes--
+	e := newStreakFreezeEvent(u.ID, FreezeUsed)
+	e.Day = &missed
+	u.freezeEvents = append(u.freezeEvents, e)
+	return true
+}
@@ -0,0 +1,41 @@
+package user
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"strings"
+	"time"
+)
+
+// TelegramLinkCodeTTL is how long a link code can be sent to the bot.
+const TelegramLinkCodeTTL = 10 * time.Minute
+
+// TelegramLinkCode is a one-time code that ties a Telegram chat to the user
+// who requested it.
+type TelegramLinkCode struct {
+	Code      string
+	UserID    string
+	ExpiresAt time.Time
+}
+
+func NewTelegramLinkCode(userID string, now time.Time) (*TelegramLinkCode, error) {
+	buf := make([]byte, 5)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, errors.New("failed to generate link code")
+	}
+	return &TelegramLinkCode{
+		Code:      base32.StdEncoding.EncodeToString(buf),
+		UserID:    userID,
+		ExpiresAt: now.Add(TelegramLinkCodeTTL),
+	}, nil
+}
+
+// NormalizeTelegramLinkCode makes codes typed by hand comparable.
+func NormalizeTelegramLinkCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func (c *TelegramLinkCode) Expired(now time.Time) bool {
+	return !now.Before(c.ExpiresAt)
+}
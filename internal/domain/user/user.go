@@ -1,28 +1,16 @@
 package user
 
 import (
+	"errors"
 	"time"
 
+	"github.com/bakhtybayevn/powerbook/internal/domain/calendar"
 	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 )
 
-// Level thresholds for the 10-level system
-var Levels = []struct {
-	Name string
-	XP   int
-}{
-	{"Newbie", 0},
-	{"Reader", 100},
-	{"Bookworm", 300},
-	{"Scholar", 600},
-	{"Sage", 1000},
-	{"Expert", 1500},
-	{"Master", 2200},
-	{"Grandmaster", 3000},
-	{"Legend", 4000},
-	{"Book King", 5500},
-}
+// DefaultTimezone is used until the user picks their own.
+const DefaultTimezone = "UTC"
 
 // User aggregate
 type User struct {
@@ -31,9 +19,23 @@ type User struct {
 	DisplayName  string
 	PasswordHash string
 
+	// SessionVersion goes up whenever the password changes; tokens issued
+	// under an older version are no longer accepted
+	SessionVersion int
+
 	// streak tracking
 	StreakCurrentDays int
 	StreakLastDate    *time.Time
+	StreakFreezes     int
+
+	// the run a freeze milestone was last paid for: the day the run started
+	// and the streak length paid. Rebuilds leave both alone, so deleting and
+	// re-logging a milestone day does not pay it twice.
+	StreakStartDate       *time.Time
+	StreakFreezeMilestone int
+
+	// freeze events recorded since the last TakeFreezeEvents call
+	freezeEvents []*StreakFreezeEvent
 
 	// analytics
 	TotalMinutes int
@@ -41,9 +43,15 @@ type User struct {
 	// XP & leveling
 	XP int
 
+	// XP ledger entries recorded since the last TakeXPTransactions call
+	xpTransactions []*XPTransaction
+
 	// social
 	TelegramHandle string
 
+	// IANA timezone name; defines the user's calendar day for streaks and caps
+	Timezone string
+
 	// admin
 	IsAdmin bool
 }
@@ -60,48 +68,61 @@ func NewUser(email, displayName, password string) *User {
 		TotalMinutes:      0,
 		XP:               0,
 		TelegramHandle:   "",
+		Timezone:          DefaultTimezone,
 	}
 }
 
-// AddXP grants experience points to the user.
-func (u *User) AddXP(amount int) {
-	u.XP += amount
+// Location returns the user's timezone, defaulting to UTC.
+func (u *User) Location() *time.Location {
+	return calendar.LoadLocation(u.Timezone)
 }
 
-// Level returns the user's current level (1-10) based on XP.
-func (u *User) Level() int {
-	lvl := 1
-	for i, l := range Levels {
-		if u.XP >= l.XP {
-			lvl = i + 1
-		}
+// SetTimezone validates and sets the user's IANA timezone (e.g. "Asia/Almaty").
+func (u *User) SetTimezone(tz string) error {
+	if tz == "" {
+		tz = DefaultTimezone
 	}
-	return lvl
-}
-
-// LevelName returns the display name for the user's current level.
-func (u *User) LevelName() string {
-	return Levels[u.Level()-1].Name
+	if _, err := time.LoadLocation(tz); err != nil {
+		return errors.New("unknown timezone: " + tz)
+	}
+	u.Timezone = tz
+	return nil
 }
 
 // LogReading performs domain logic for a user's reading entry.
-// minutes must be > 0. timestamp is the time of the reading event (UTC).
+// minutes must be > 0. timestamp is the time of the reading event; it is
+// bucketed into a calendar day using the user's timezone.
 // Returns newStreak, totalMinutes, and an error (domain error via core.AppError should be returned by application layer if needed).
 func (u *User) LogReading(minutes int, timestamp time.Time) (newStreak int, totalMinutes int) {
-	// Normalize timestamp to date (UTC) for streak counting
-	t := timestamp.UTC()
-	today := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	return u.applyReading(minutes, timestamp, nil)
+}
+
+// ReplayReading re-applies a historical log while rebuilding stats from
+// scratch. Freezes are neither earned nor spent; a single missed day is
+// bridged only if it is listed in frozenDays (days already covered by a used freeze).
+func (u *User) ReplayReading(minutes int, timestamp time.Time, frozenDays map[time.Time]bool) {
+	if frozenDays == nil {
+		frozenDays = map[time.Time]bool{}
+	}
+	u.applyReading(minutes, timestamp, frozenDays)
+}
+
+// applyReading holds the streak logic shared by LogReading and ReplayReading.
+// frozenDays is nil for live logs.
+func (u *User) applyReading(minutes int, timestamp time.Time, frozenDays map[time.Time]bool) (newStreak int, totalMinutes int) {
+	// Normalize timestamp to the user's local date for streak counting
+	today := calendar.Day(timestamp, u.Location())
 
-	// Determine previous day date
+	// Determine previous day date (already stored as a calendar date)
 	var yesterday time.Time
 	if u.StreakLastDate != nil {
-		ld := u.StreakLastDate.UTC()
-		yesterday = time.Date(ld.Year(), ld.Month(), ld.Day(), 0, 0, 0, 0, time.UTC)
+		yesterday = calendar.Day(*u.StreakLastDate, time.UTC)
 	}
 
 	// Update streak logic
 	if u.StreakLastDate == nil {
 		u.StreakCurrentDays = 1
+		u.startStreakRun(today, frozenDays)
 	} else if today.Equal(yesterday) {
 		// multiple logs in same day -> do not increment streak (streak counts days with at least one log)
 		// but keep streak unchanged
@@ -111,8 +132,17 @@ func (u *User) LogReading(minutes int, timestamp time.Time) (newStreak int, tota
 		prevDay := yesterday.AddDate(0, 0, 1)
 		if prevDay.Equal(today) {
 			u.StreakCurrentDays += 1
+		} else if prevDay.AddDate(0, 0, 1).Equal(today) && u.bridgeMissedDay(prevDay, frozenDays) {
+			// exactly one missed day covered by a streak freeze
+			u.StreakCurrentDays += 1
 		} else {
 			u.StreakCurrentDays = 1
+			u.startStreakRun(today, frozenDays)
+		}
+
+		if frozenDays == nil && u.StreakCurrentDays%StreakFreezeEarnEvery == 0 && u.StreakCurrentDays > u.StreakFreezeMilestone {
+			u.StreakFreezeMilestone = u.StreakCurrentDays
+			u.earnStreakFreeze()
 		}
 	}
 
@@ -125,6 +155,29 @@ func (u *User) LogReading(minutes int, timestamp time.Time) (newStreak int, tota
 	return u.StreakCurrentDays, u.TotalMinutes
 }
 
+// startStreakRun notes that a live log began a new streak run. Milestones
+// paid for an earlier run no longer count; a run that starts on the same day
+// again (its logs were deleted and logged anew) keeps what it was paid.
+func (u *User) startStreakRun(day time.Time, frozenDays map[time.Time]bool) {
+	if frozenDays != nil {
+		return // replays leave the paid milestones alone
+	}
+	if u.StreakStartDate != nil && calendar.Day(*u.StreakStartDate, time.UTC).Equal(day) {
+		return
+	}
+	u.StreakStartDate = &day
+	u.StreakFreezeMilestone = 0
+}
+
+// ResetReadingStats clears everything derived from reading logs so it can be
+// rebuilt by replaying the remaining logs through ReplayReading in chronological order.
+// The streak freeze balance and the milestones already paid are kept as is.
+func (u *User) ResetReadingStats() {
+	u.StreakCurrentDays = 0
+	u.StreakLastDate = nil
+	u.TotalMinutes = 0
+}
+
 func (u *User) CheckPassword(password string) bool {
 	return bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(password)) == nil
 }
@@ -0,0 +1,73 @@
+package user
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// XPReason says why a user's XP changed.
+type XPReason string
+
+const (
+	// XPOpeningBalance carries over XP granted before the ledger existed.
+	XPOpeningBalance XPReason = "opening_balance"
+	XPCompetition    XPReason = "competition"
+	XPSeason         XPReason = "season"
+	XPAchievement    XPReason = "achievement"
+	XPStreakFreeze   XPReason = "streak_freeze"
+)
+
+// XPSource points at what an XP change was awarded for. At most one field is set.
+type XPSource struct {
+	CompetitionID string
+	SeasonID      string
+	AchievementID string
+}
+
+// XPTransaction is one entry of the append-only XP ledger. A user's XP is
+// the sum of their transactions.
+type XPTransaction struct {
+	ID        string
+	UserID    string
+	Amount    int // negative when XP is spent
+	Reason    XPReason
+	Source    XPSource
+	CreatedAt time.Time
+}
+
+// AddXP changes the user's XP and records why in the ledger. Zero amounts
+// are ignored.
+func (u *User) AddXP(amount int, reason XPReason, source XPSource) {
+	if amount == 0 {
+		return
+	}
+	u.XP += amount
+	u.xpTransactions = append(u.xpTransactions, &XPTransaction{
+		ID:        uuid.New().String(),
+		UserID:    u.ID,
+		Amount:    amount,
+		Reason:    reason,
+		Source:    source,
+		CreatedAt: time.Now().UTC(),
+	})
+}
+
+// TakeXPTransactions returns ledger entries recorded since the last call and
+// clears them, so the application layer can persist each one exactly once.
+func (u *User) TakeXPTransactions() []*XPTransaction {
+	txs := u.xpTransactions
+	u.xpTransactions = nil
+	return txs
+}
+
+// RecomputeXP replaces the XP balance with the sum of the ledger and
+// returns the previous balance.
+func (u *User) RecomputeXP(ledger []*XPTransaction) int {
+	previous := u.XP
+	u.XP = 0
+	for _, t := range ledger {
+		u.XP += t.Amount
+	}
+	return previous
+}
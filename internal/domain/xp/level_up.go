@@ -0,0 +1,43 @@
+package xp
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LevelUp records a user reaching a level for the first time. Levels can be
+// lost by spending XP, but a level is only ever reached, and rewarded, once.
+type LevelUp struct {
+	ID            string
+	UserID        string
+	Level         int
+	LevelName     string
+	XP            int // balance right after crossing
+	PolicyVersion int
+	Reward        Reward
+	CreatedAt     time.Time
+}
+
+// LevelUps returns an event for every level crossed going from fromXP to toXP,
+// lowest first. Nothing is crossed when XP goes down.
+func (p Policy) LevelUps(userID string, fromXP, toXP int) []*LevelUp {
+	from, _ := p.Level(fromXP)
+	to, _ := p.Level(toXP)
+
+	var out []*LevelUp
+	now := time.Now().UTC()
+	for lvl := from + 1; lvl <= to; lvl++ {
+		out = append(out, &LevelUp{
+			ID:            uuid.New().String(),
+			UserID:        userID,
+			Level:         lvl,
+			LevelName:     p.Levels[lvl-1].Name,
+			XP:            toXP,
+			PolicyVersion: p.Version,
+			Reward:        RewardFor(lvl),
+			CreatedAt:     now,
+		})
+	}
+	return out
+}
@@ -0,0 +1,189 @@
+package xp
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Level is a named XP threshold.
+type Level struct {
+	Name string
+	XP   int // XP needed to reach the level
+}
+
+// Policy holds the XP rewards for finishing a competition or a season and
+// the level thresholds. Policies are versioned: a change creates a new version, and a
+// competition records the version it was closed under.
+type Policy struct {
+	Version int
+
+	// placement rewards
+	FirstPlaceXP  int
+	SecondPlaceXP int
+	ThirdPlaceXP  int
+	TopHalfXP     int // everyone else in the top 50%
+	BottomHalfXP  int // participation
+
+	// activity bonuses
+	PerDayXP        int // per day read during the competition
+	PerfectStreakXP int // read every day of the competition
+
+	// season-end rewards
+	SeasonFirstXP      int
+	SeasonSecondXP     int
+	SeasonThirdXP      int
+	SeasonTopHalfXP    int
+	SeasonBottomHalfXP int
+	SeasonWinXP        int // per competition of the season won
+
+	Levels []Level // ascending; the first level starts at 0 XP
+
+	CreatedBy string
+	CreatedAt time.Time
+}
+
+// DefaultPolicy is version 1, the rewards and levels used before policies
+// became configurable.
+func DefaultPolicy() Policy {
+	return Policy{
+		Version:         1,
+		FirstPlaceXP:    200,
+		SecondPlaceXP:   150,
+		ThirdPlaceXP:    100,
+		TopHalfXP:       50,
+		BottomHalfXP:    20,
+		PerDayXP:        5,
+		PerfectStreakXP: 30,
+
+		SeasonFirstXP:      500,
+		SeasonSecondXP:     300,
+		SeasonThirdXP:      200,
+		SeasonTopHalfXP:    100,
+		SeasonBottomHalfXP: 30,
+		SeasonWinXP:        25,
+
+		Levels: []Level{
+			{"Newbie", 0},
+			{"Reader", 100},
+			{"Bookworm", 300},
+			{"Scholar", 600},
+			{"Sage", 1000},
+			{"Expert", 1500},
+			{"Master", 2200},
+			{"Grandmaster", 3000},
+			{"Legend", 4000},
+			{"Book King", 5500},
+		},
+	}
+}
+
+func (p Policy) Validate() error {
+	for name, v := range map[string]int{
+		"first_place_xp":    p.FirstPlaceXP,
+		"second_place_xp":   p.SecondPlaceXP,
+		"third_place_xp":    p.ThirdPlaceXP,
+		"top_half_xp":       p.TopHalfXP,
+		"bottom_half_xp":    p.BottomHalfXP,
+		"per_day_xp":        p.PerDayXP,
+		"perfect_streak_xp": p.PerfectStreakXP,
+
+		"season_first_xp":       p.SeasonFirstXP,
+		"season_second_xp":      p.SeasonSecondXP,
+		"season_third_xp":       p.SeasonThirdXP,
+		"season_top_half_xp":    p.SeasonTopHalfXP,
+		"season_bottom_half_xp": p.SeasonBottomHalfXP,
+		"season_win_xp":         p.SeasonWinXP,
+	} {
+		if v < 0 {
+			return fmt.Errorf("%s cannot be negative", name)
+		}
+	}
+
+	if len(p.Levels) == 0 {
+		return errors.New("at least one level is required")
+	}
+	if p.Levels[0].XP != 0 {
+		return errors.New("the first level must start at 0 XP")
+	}
+	for i, l := range p.Levels {
+		if l.Name == "" {
+			return fmt.Errorf("level %d needs a name", i+1)
+		}
+		if i > 0 && l.XP <= p.Levels[i-1].XP {
+			return errors.New("level thresholds must be strictly increasing")
+		}
+	}
+	return nil
+}
+
+// CompetitionXP is the XP for finishing at rank (1-based) out of total
+// places, having read on daysRead of the competition's compDays days.
+func (p Policy) CompetitionXP(rank, total, daysRead, compDays int) int {
+	xp := 0
+
+	// Position-based XP
+	switch rank {
+	case 1:
+		xp += p.FirstPlaceXP
+	case 2:
+		xp += p.SecondPlaceXP
+	case 3:
+		xp += p.ThirdPlaceXP
+	default:
+		mid := total / 2
+		if total%2 != 0 {
+			mid++
+		}
+		if rank <= mid {
+			xp += p.TopHalfXP
+		} else {
+			xp += p.BottomHalfXP
+		}
+	}
+
+	// Per-day bonus
+	xp += daysRead * p.PerDayXP
+
+	// Perfect streak bonus (read every day of competition)
+	if compDays > 0 && daysRead >= compDays {
+		xp += p.PerfectStreakXP
+	}
+
+	return xp
+}
+
+// SeasonXP is the XP for finishing a season at rank (1-based) out of total
+// places, having won wins of its competitions.
+func (p Policy) SeasonXP(rank, total, wins int) int {
+	xp := 0
+	switch rank {
+	case 1:
+		xp += p.SeasonFirstXP
+	case 2:
+		xp += p.SeasonSecondXP
+	case 3:
+		xp += p.SeasonThirdXP
+	default:
+		if rank <= (total+1)/2 {
+			xp += p.SeasonTopHalfXP
+		} else {
+			xp += p.SeasonBottomHalfXP
+		}
+	}
+	return xp + wins*p.SeasonWinXP
+}
+
+// Level returns the 1-based level reached with the given XP and its name.
+func (p Policy) Level(xp int) (int, string) {
+	if len(p.Levels) == 0 {
+		return 1, ""
+	}
+	lvl := 1
+	for i, l := range p.Levels {
+		if xp >= l.XP {
+			lvl = i + 1
+		}
+	}
+	return lvl, p.Levels[lvl-1].Name
+}
@@ -0,0 +1,51 @@
+package xp
+
+// Reward is what reaching a level unlocks. Rewards are tied to the level
+// number, so they stay put when an admin renames a level or moves its
+// threshold.
+type Reward struct {
+	StreakFreezes      int    // granted once, when the level is reached
+	Flair              string // profile decoration, replaced by higher levels' flair
+	PublicCompetitions bool   // may create public competitions
+}
+
+// PublicCompetitionsLevel is the level that unlocks creating public competitions.
+const PublicCompetitionsLevel = 3
+
+var rewards = map[int]Reward{
+	2:                       {StreakFreezes: 1},
+	PublicCompetitionsLevel: {Flair: "bookworm", PublicCompetitions: true},
+	4:                       {StreakFreezes: 1},
+	5:                       {Flair: "sage"},
+	6:                       {StreakFreezes: 1},
+	7:                       {Flair: "master"},
+	8:                       {StreakFreezes: 1},
+	9:                       {Flair: "legend"},
+	10:                      {StreakFreezes: 1, Flair: "crown"},
+}
+
+// RewardFor returns the reward for reaching level; most levels have one.
+func RewardFor(level int) Reward {
+	return rewards[level]
+}
+
+// Perks are the lasting rewards of every level up to the highest one reached.
+type Perks struct {
+	Flair              string
+	PublicCompetitions bool
+}
+
+// PerksAt collects the lasting rewards of levels 1..level.
+func PerksAt(level int) Perks {
+	var p Perks
+	for lvl := 1; lvl <= level; lvl++ {
+		r := rewards[lvl]
+		if r.Flair != "" {
+			p.Flair = r.Flair
+		}
+		if r.PublicCompetitions {
+			p.PublicCompetitions = true
+		}
+	}
+	return p
+}
@@ -0,0 +1,11 @@
+package ports
+
+import "github.com/bakhtybayevn/powerbook/internal/domain/achievement"
+
+// AchievementRepository stores the achievements users have unlocked
+type AchievementRepository interface {
+	// Unlock records the unlock and reports false if the user already had it.
+	Unlock(u *achievement.Unlock) (bool, error)
+	// ListByUser returns the user's unlocks, oldest first.
+	ListByUser(userID string) ([]*achievement.Unlock, error)
+}
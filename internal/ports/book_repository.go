@@ -0,0 +1,11 @@
+package ports
+
+import "github.com/bakhtybayevn/powerbook/internal/domain/book"
+
+// BookRepository is a port for the book catalog
+type BookRepository interface {
+	Save(b *book.Book) error
+	Get(id string) (*book.Book, error)
+	FindByISBN(isbn string) (*book.Book, error)
+	Search(query string, limit int) ([]*book.Book, error)
+}
@@ -8,12 +8,41 @@ import (
 
 type CompetitionRepository interface {
 	Create(c *competition.Competition) error
+	// Save writes the editable fields. Status and the XP policy version are
+	// left alone; they only change through TransitionStatus and
+	// SetXPPolicyVersion, so a stale copy cannot reopen a closed competition.
 	Save(c *competition.Competition) error
+	// TransitionStatus moves the competition from one status to another and
+	// reports false, changing nothing, when it is no longer in status from.
+	TransitionStatus(id string, from, to competition.Status) (bool, error)
+	// SetXPPolicyVersion records the XP policy the competition was closed under.
+	SetXPPolicyVersion(id string, version int) error
 	SaveParticipant(competitionID string, p *competition.Participant) error
+	DeleteParticipant(competitionID, userID string) error
+	RecordKick(competitionID, userID, kickedBy, reason string) error
+	IsKicked(competitionID, userID string) (bool, error)
+	SaveTeam(t *competition.Team) error
+	Delete(id string) error
+	AddOrganizer(competitionID, userID string) error
+	RemoveOrganizer(competitionID, userID string) error
 	Get(id string) (*competition.Competition, error)
+	// GetForUpdate is Get that also locks the competition until the unit of
+	// work ends, so checks like the seat limit hold until the write commits.
+	GetForUpdate(id string) (*competition.Competition, error)
 	FindActive(at time.Time) ([]*competition.Competition, error)
 	GetAll() ([]*competition.Competition, error)
 	FindByUser(userID string) ([]*competition.Competition, error)
+	FindByInviteCode(code string) (*competition.Competition, error)
+	FindBySeries(seriesID string) ([]*competition.Competition, error)
+
+	// Join requests (competitions that require approval)
+	SaveJoinRequest(jr *competition.JoinRequest) error
+	GetJoinRequest(competitionID, userID string) (*competition.JoinRequest, error)
+	ListJoinRequests(competitionID string, status competition.JoinRequestStatus) ([]*competition.JoinRequest, error)
+
+	// Final standings, written once when the competition closes
+	SaveResults(competitionID string, rows []competition.Result) error
+	GetResults(competitionID string) ([]competition.Result, error)
 
 	// Gift exchanges
 	SaveGiftExchange(g *competition.GiftExchange) error
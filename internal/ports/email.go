@@ -0,0 +1,30 @@
+package ports
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bakhtybayevn/powerbook/internal/domain/email"
+)
+
+// ErrEmailRejected marks a send the mail server refused for good (e.g. an
+// unknown mailbox); retrying it cannot succeed.
+var ErrEmailRejected = errors.New("email rejected")
+
+// EmailSender hands a message to the mail server.
+type EmailSender interface {
+	Send(ctx context.Context, m email.Message) error
+}
+
+// EmailOutboxRepository stores messages until they are sent.
+type EmailOutboxRepository interface {
+	// Enqueue returns false, storing nothing, when a message with the same
+	// non-empty key was enqueued before.
+	Enqueue(m *email.OutboxMessage) (bool, error)
+	// ClaimDue returns up to limit pending messages due at now and pushes
+	// their next attempt back by lease, so a concurrent relay skips them.
+	ClaimDue(now time.Time, limit int, lease time.Duration) ([]*email.OutboxMessage, error)
+	// Save records the outcome of an attempt.
+	Save(m *email.OutboxMessage) error
+}
@@ -7,10 +7,31 @@ type LeaderboardEntry struct {
 	Score  float64
 }
 
+type TeamLeaderboardEntry struct {
+	TeamID string
+	Score  float64
+}
+
 type LeaderboardPort interface {
 	AddScore(ctx context.Context, competitionID string, userID string, delta float64) (float64, error)
+	SetScore(ctx context.Context, competitionID string, userID string, score float64) error
+	// GetTop returns the best entries first; limit <= 0 returns every entry.
 	GetTop(ctx context.Context, competitionID string, limit int) ([]LeaderboardEntry, error)
 	GetRank(ctx context.Context, competitionID string, userID string) (rank int64, score float64, err error)
+	RemoveMember(ctx context.Context, competitionID string, userID string) error
+
+	// Team boards live next to the individual board; entries are keyed by team ID.
+	// As with GetTop, a limit <= 0 returns every team.
+	AddTeamScore(ctx context.Context, competitionID string, teamID string, delta float64) (float64, error)
+	SetTeamScore(ctx context.Context, competitionID string, teamID string, score float64) error
+	GetTopTeams(ctx context.Context, competitionID string, limit int) ([]TeamLeaderboardEntry, error)
+
+	// Clear drops every board of a competition.
+	Clear(ctx context.Context, competitionID string) error
+
+	// Rebuild atomically replaces both boards of a competition with the
+	// given entries, e.g. from the participants table after a Redis flush.
+	Rebuild(ctx context.Context, competitionID string, entries []LeaderboardEntry, teams []TeamLeaderboardEntry) error
 }
 
 type LeaderboardHealthPort interface {
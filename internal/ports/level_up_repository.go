@@ -0,0 +1,11 @@
+package ports
+
+import "github.com/bakhtybayevn/powerbook/internal/domain/xp"
+
+// LevelUpRepository stores the levels users have reached
+type LevelUpRepository interface {
+	// Record saves the event and reports false if the user had already reached that level.
+	Record(e *xp.LevelUp) (bool, error)
+	// ListByUser returns the user's level-ups, newest first.
+	ListByUser(userID string) ([]*xp.LevelUp, error)
+}
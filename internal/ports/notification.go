@@ -0,0 +1,39 @@
+package ports
+
+import (
+	"time"
+
+	"github.com/bakhtybayevn/powerbook/internal/domain/notification"
+)
+
+// Notifier hands notifications to the notification subsystem. Use cases
+// call it after their changes have committed; it never fails the caller.
+type Notifier interface {
+	Notify(ns ...*notification.Notification)
+}
+
+// NotificationChannel delivers a notification outside the app.
+type NotificationChannel interface {
+	Channel() notification.Channel
+	Deliver(n *notification.Notification) error
+}
+
+// NotificationRepository is the in-app inbox.
+type NotificationRepository interface {
+	// Save stores n; it returns false, storing nothing, when the user
+	// already has a notification with the same non-empty key.
+	Save(n *notification.Notification) (bool, error)
+	// ListByUser returns the user's notifications, newest first; limit <= 0 returns all.
+	ListByUser(userID string, unreadOnly bool, limit int) ([]*notification.Notification, error)
+	CountUnread(userID string) (int, error)
+	// MarkRead fails with NotFoundError when the user has no such notification.
+	MarkRead(userID, id string, at time.Time) error
+	// MarkAllRead returns how many notifications it marked.
+	MarkAllRead(userID string, at time.Time) (int, error)
+}
+
+type NotificationPreferenceRepository interface {
+	// Get returns the defaults when the user never saved preferences.
+	Get(userID string) (*notification.Preferences, error)
+	Save(p *notification.Preferences) error
+}
@@ -0,0 +1,14 @@
+package ports
+
+import "github.com/bakhtybayevn/powerbook/internal/domain/user"
+
+// PasswordResetRepository keeps pending password resets by token hash.
+type PasswordResetRepository interface {
+	Save(t *user.PasswordResetToken) error
+	// Take returns the reset and deletes it, so a token works once.
+	Take(tokenHash string) (*user.PasswordResetToken, error)
+	// LatestByUser returns the user's most recent pending reset.
+	LatestByUser(userID string) (*user.PasswordResetToken, error)
+	// DeleteByUser drops every pending reset of the user.
+	DeleteByUser(userID string) error
+}
@@ -9,6 +9,10 @@ import (
 // ReadingRepository is a port for persisting reading entries
 type ReadingRepository interface {
 	Save(r *reading.Reading) error
+	Get(id string) (*reading.Reading, error)
+	Update(r *reading.Reading) error
+	Delete(id string) error
 	ListByUser(userID string) ([]reading.Reading, error)
+	// ListByDateRange returns logs with from <= timestamp < to
 	ListByDateRange(userID string, from, to time.Time) ([]reading.Reading, error)
 }
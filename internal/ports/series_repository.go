@@ -0,0 +1,25 @@
+package ports
+
+import (
+	"time"
+
+	"github.com/bakhtybayevn/powerbook/internal/domain/competition"
+)
+
+type SeriesRepository interface {
+	SaveSeries(s *competition.Series) error
+	GetSeries(id string) (*competition.Series, error)
+	ListActiveSeries() ([]*competition.Series, error)
+
+	SaveSeason(s *competition.Season) error
+	// CloseSeason moves an open season to closed under an XP policy version
+	// and reports false, changing nothing, when it was no longer open.
+	CloseSeason(id string, xpPolicyVersion int) (bool, error)
+	GetSeason(id string) (*competition.Season, error)
+	ListSeasons(seriesID string) ([]*competition.Season, error)
+	ListOpenSeasonsEndedBefore(t time.Time) ([]*competition.Season, error)
+
+	// final table, written once when a season closes
+	SaveSeasonStandings(seasonID string, rows []competition.SeasonStanding) error
+	GetSeasonStandings(seasonID string) ([]competition.SeasonStanding, error)
+}
@@ -0,0 +1,9 @@
+package ports
+
+import "github.com/bakhtybayevn/powerbook/internal/domain/user"
+
+// StreakFreezeRepository stores the history of earned, bought and used streak freezes
+type StreakFreezeRepository interface {
+	SaveEvent(e *user.StreakFreezeEvent) error
+	ListByUser(userID string) ([]*user.StreakFreezeEvent, error)
+}
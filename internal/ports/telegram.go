@@ -0,0 +1,42 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/bakhtybayevn/powerbook/internal/domain/user"
+)
+
+// TelegramUpdate is an incoming chat message.
+type TelegramUpdate struct {
+	UpdateID int64
+	ChatID   int64
+	Private  bool   // a one-to-one chat with the bot rather than a group or channel
+	FromID   int64  // sender's Telegram user id; 0 for channel posts
+	Username string // sender's @username, without the @; may be empty
+	Text     string
+}
+
+// TelegramBot is the Bot API client.
+type TelegramBot interface {
+	// GetUpdates long-polls for messages with an update id >= offset.
+	GetUpdates(ctx context.Context, offset int64, timeout time.Duration) ([]TelegramUpdate, error)
+	SendMessage(ctx context.Context, chatID int64, text string) error
+}
+
+// TelegramLinkRepository keeps link codes and the Telegram accounts linked to
+// users. Accounts are keyed by the Telegram user id, which is also the id of
+// the user's private chat with the bot.
+type TelegramLinkRepository interface {
+	SaveCode(c *user.TelegramLinkCode) error
+	// TakeCode returns the code and deletes it, so it works once.
+	TakeCode(code string) (*user.TelegramLinkCode, error)
+
+	// Link ties the Telegram account to the user, replacing any earlier link of either.
+	Link(userID string, telegramID int64) error
+	Unlink(userID string) error
+	// ChatID returns the user's private chat id, 0 when the user has no linked account.
+	ChatID(userID string) (int64, error)
+	// UserID returns "" when the Telegram account is not linked.
+	UserID(telegramID int64) (string, error)
+}
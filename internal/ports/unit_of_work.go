@@ -0,0 +1,23 @@
+package ports
+
+// Repositories are the repositories bound to one unit of work.
+type Repositories struct {
+	Users        UserRepository
+	Readings     ReadingRepository
+	Competitions CompetitionRepository
+	Freezes      StreakFreezeRepository
+	XPLedger     XPLedgerRepository
+	Achievements AchievementRepository
+	XPPolicies   XPPolicyRepository
+	LevelUps     LevelUpRepository
+	Series       SeriesRepository
+	Resets       PasswordResetRepository
+	EmailOutbox  EmailOutboxRepository
+}
+
+// UnitOfWork runs a use case's writes atomically.
+type UnitOfWork interface {
+	// Do calls fn with repositories sharing one transaction. It commits when
+	// fn returns nil and rolls everything back when fn returns an error.
+	Do(fn func(tx Repositories) error) error
+}
@@ -4,7 +4,15 @@ import "github.com/bakhtybayevn/powerbook/internal/domain/user"
 
 type UserRepository interface {
 	Get(id string) (*user.User, error)
+	// Save inserts or updates the user. The password and session version of
+	// an existing user are left alone; they only change through UpdatePassword.
 	Save(u *user.User) error
+	// UpdatePassword stores a new password hash and bumps the session version,
+	// so every token issued before stops working.
+	UpdatePassword(id, passwordHash string) error
+	// UpdateProfile writes only the display name, Telegram handle and
+	// timezone, so XP or freezes granted meanwhile are not overwritten.
+	UpdateProfile(u *user.User) error
 	FindByEmail(email string) (*user.User, error)
 	ListAll() ([]*user.User, error)
 	Delete(id string) error
@@ -0,0 +1,10 @@
+package ports
+
+import "github.com/bakhtybayevn/powerbook/internal/domain/user"
+
+// XPLedgerRepository stores the append-only history of XP changes
+type XPLedgerRepository interface {
+	Append(t *user.XPTransaction) error
+	// ListByUser returns the user's ledger, newest first.
+	ListByUser(userID string) ([]*user.XPTransaction, error)
+}
@@ -0,0 +1,13 @@
+package ports
+
+import "github.com/bakhtybayevn/powerbook/internal/domain/xp"
+
+// XPPolicyRepository stores every version of the XP policy; the highest
+// version is the one in force.
+type XPPolicyRepository interface {
+	Current() (*xp.Policy, error)
+	Get(version int) (*xp.Policy, error)
+	List() ([]*xp.Policy, error) // newest first
+	// Create inserts p as a new version and fails if that version exists.
+	Create(p *xp.Policy) error
+}
@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS books (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    title TEXT NOT NULL,
+    author TEXT NOT NULL DEFAULT '',
+    isbn TEXT NOT NULL DEFAULT '',
+    page_count INT NOT NULL DEFAULT 0,
+    cover_url TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_books_isbn ON books(isbn) WHERE isbn <> '';
+CREATE INDEX IF NOT EXISTS idx_books_title ON books(LOWER(title));
+
+ALTER TABLE reading_logs ADD COLUMN book_id UUID NULL REFERENCES books(id) ON DELETE SET NULL;
+ALTER TABLE reading_logs ADD COLUMN pages_read INT NOT NULL DEFAULT 0;
+CREATE INDEX IF NOT EXISTS idx_reading_book ON reading_logs(book_id);
+
+-- +goose Down
+ALTER TABLE reading_logs DROP COLUMN IF EXISTS pages_read;
+ALTER TABLE reading_logs DROP COLUMN IF EXISTS book_id;
+DROP TABLE IF EXISTS books;
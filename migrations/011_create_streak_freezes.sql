@@ -0,0 +1,16 @@
+-- +goose Up
+ALTER TABLE users ADD COLUMN streak_freezes INT NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS streak_freeze_events (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    kind TEXT NOT NULL,
+    day DATE NULL,
+    xp_cost INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_streak_freeze_user ON streak_freeze_events(user_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS streak_freeze_events;
+ALTER TABLE users DROP COLUMN IF EXISTS streak_freezes;
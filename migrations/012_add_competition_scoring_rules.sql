@@ -0,0 +1,27 @@
+-- +goose Up
+ALTER TABLE competitions ADD COLUMN scoring_type TEXT NOT NULL DEFAULT 'minutes';
+ALTER TABLE competitions ADD COLUMN points_per_unit INT NOT NULL DEFAULT 1;
+ALTER TABLE competitions ADD COLUMN daily_minute_cap INT NOT NULL DEFAULT 0;
+
+ALTER TABLE participants ADD COLUMN pages_total INT NOT NULL DEFAULT 0;
+ALTER TABLE participants ADD COLUMN books_finished INT NOT NULL DEFAULT 0;
+ALTER TABLE participants ADD COLUMN streak_current INT NOT NULL DEFAULT 0;
+ALTER TABLE participants ADD COLUMN streak_longest INT NOT NULL DEFAULT 0;
+ALTER TABLE participants ADD COLUMN day_minutes INT NOT NULL DEFAULT 0;
+ALTER TABLE participants ADD COLUMN capped_minutes INT NOT NULL DEFAULT 0;
+
+ALTER TABLE reading_logs ADD COLUMN finished_book BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose Down
+ALTER TABLE reading_logs DROP COLUMN IF EXISTS finished_book;
+
+ALTER TABLE participants DROP COLUMN IF EXISTS capped_minutes;
+ALTER TABLE participants DROP COLUMN IF EXISTS day_minutes;
+ALTER TABLE participants DROP COLUMN IF EXISTS streak_longest;
+ALTER TABLE participants DROP COLUMN IF EXISTS streak_current;
+ALTER TABLE participants DROP COLUMN IF EXISTS books_finished;
+ALTER TABLE participants DROP COLUMN IF EXISTS pages_total;
+
+ALTER TABLE competitions DROP COLUMN IF EXISTS daily_minute_cap;
+ALTER TABLE competitions DROP COLUMN IF EXISTS points_per_unit;
+ALTER TABLE competitions DROP COLUMN IF EXISTS scoring_type;
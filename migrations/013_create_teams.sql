@@ -0,0 +1,19 @@
+-- +goose Up
+ALTER TABLE competitions ADD COLUMN format TEXT NOT NULL DEFAULT 'individual';
+
+CREATE TABLE IF NOT EXISTS teams (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    competition_id UUID NOT NULL REFERENCES competitions(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    captain_id UUID NULL REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_teams_comp_name ON teams(competition_id, LOWER(name));
+
+ALTER TABLE participants ADD COLUMN team_id UUID NULL REFERENCES teams(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_participants_team ON participants(team_id);
+
+-- +goose Down
+ALTER TABLE participants DROP COLUMN IF EXISTS team_id;
+DROP TABLE IF EXISTS teams;
+ALTER TABLE competitions DROP COLUMN IF EXISTS format;
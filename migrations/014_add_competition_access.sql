@@ -0,0 +1,27 @@
+-- +goose Up
+ALTER TABLE competitions ADD COLUMN owner_id UUID NULL REFERENCES users(id) ON DELETE SET NULL;
+ALTER TABLE competitions ADD COLUMN visibility TEXT NOT NULL DEFAULT 'public';
+ALTER TABLE competitions ADD COLUMN invite_code TEXT NULL;
+ALTER TABLE competitions ADD COLUMN requires_approval BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE competitions ADD COLUMN max_participants INT NOT NULL DEFAULT 0;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_competitions_invite_code ON competitions(invite_code);
+
+CREATE TABLE IF NOT EXISTS join_requests (
+    competition_id UUID NOT NULL REFERENCES competitions(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    team_id UUID NULL REFERENCES teams(id) ON DELETE SET NULL,
+    status TEXT NOT NULL DEFAULT 'pending',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    decided_at TIMESTAMP NULL,
+    PRIMARY KEY (competition_id, user_id)
+);
+CREATE INDEX IF NOT EXISTS idx_join_requests_status ON join_requests(competition_id, status);
+
+-- +goose Down
+DROP TABLE IF EXISTS join_requests;
+DROP INDEX IF EXISTS idx_competitions_invite_code;
+ALTER TABLE competitions DROP COLUMN IF EXISTS max_participants;
+ALTER TABLE competitions DROP COLUMN IF EXISTS requires_approval;
+ALTER TABLE competitions DROP COLUMN IF EXISTS invite_code;
+ALTER TABLE competitions DROP COLUMN IF EXISTS visibility;
+ALTER TABLE competitions DROP COLUMN IF EXISTS owner_id;
@@ -0,0 +1,10 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS competition_organizers (
+    competition_id UUID NOT NULL REFERENCES competitions(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (competition_id, user_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS competition_organizers;
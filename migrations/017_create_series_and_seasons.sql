@@ -0,0 +1,49 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS competition_series (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name TEXT NOT NULL,
+    owner_id UUID NULL REFERENCES users(id) ON DELETE SET NULL,
+    recurrence TEXT NOT NULL,
+    points_per_minute INT NOT NULL DEFAULT 1,
+    scoring_type TEXT NOT NULL DEFAULT 'minutes',
+    points_per_unit INT NOT NULL DEFAULT 1,
+    daily_minute_cap INT NOT NULL DEFAULT 0,
+    format TEXT NOT NULL DEFAULT 'individual',
+    visibility TEXT NOT NULL DEFAULT 'public',
+    requires_approval BOOLEAN NOT NULL DEFAULT FALSE,
+    max_participants INT NOT NULL DEFAULT 0,
+    next_start TIMESTAMP NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE competitions ADD COLUMN series_id UUID NULL REFERENCES competition_series(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_competitions_series ON competitions(series_id);
+
+CREATE TABLE IF NOT EXISTS seasons (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    series_id UUID NOT NULL REFERENCES competition_series(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    start_date TIMESTAMP NOT NULL,
+    end_date TIMESTAMP NOT NULL,
+    status TEXT NOT NULL DEFAULT 'open',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_seasons_series ON seasons(series_id);
+
+CREATE TABLE IF NOT EXISTS season_standings (
+    season_id UUID NOT NULL REFERENCES seasons(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    rank INT NOT NULL,
+    points INT NOT NULL DEFAULT 0,
+    competitions INT NOT NULL DEFAULT 0,
+    wins INT NOT NULL DEFAULT 0,
+    xp_earned INT NOT NULL DEFAULT 0,
+    PRIMARY KEY (season_id, user_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS season_standings;
+DROP TABLE IF EXISTS seasons;
+ALTER TABLE competitions DROP COLUMN IF EXISTS series_id;
+DROP TABLE IF EXISTS competition_series;
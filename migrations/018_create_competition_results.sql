@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS competition_results (
+    competition_id UUID NOT NULL REFERENCES competitions(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    rank INT NOT NULL,
+    points INT NOT NULL DEFAULT 0,
+    days_read INT NOT NULL DEFAULT 0,
+    minutes_total INT NOT NULL DEFAULT 0,
+    xp_earned INT NOT NULL DEFAULT 0,
+    gift_group TEXT NOT NULL DEFAULT 'neutral',
+    team_id UUID NULL,
+    team_name TEXT NOT NULL DEFAULT '',
+    team_rank INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (competition_id, user_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS competition_results;
@@ -0,0 +1,37 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS xp_policies (
+    version INT PRIMARY KEY,
+    first_place_xp INT NOT NULL,
+    second_place_xp INT NOT NULL,
+    third_place_xp INT NOT NULL,
+    top_half_xp INT NOT NULL,
+    bottom_half_xp INT NOT NULL,
+    per_day_xp INT NOT NULL,
+    perfect_streak_xp INT NOT NULL,
+    levels JSONB NOT NULL,
+    created_by UUID NULL REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- version 1: the rewards and levels that used to be hard-coded
+INSERT INTO xp_policies (version, first_place_xp, second_place_xp, third_place_xp, top_half_xp, bottom_half_xp,
+    per_day_xp, perfect_streak_xp, levels)
+VALUES (1, 200, 150, 100, 50, 20, 5, 30, '[
+    {"name": "Newbie", "xp": 0},
+    {"name": "Reader", "xp": 100},
+    {"name": "Bookworm", "xp": 300},
+    {"name": "Scholar", "xp": 600},
+    {"name": "Sage", "xp": 1000},
+    {"name": "Expert", "xp": 1500},
+    {"name": "Master", "xp": 2200},
+    {"name": "Grandmaster", "xp": 3000},
+    {"name": "Legend", "xp": 4000},
+    {"name": "Book King", "xp": 5500}
+]')
+ON CONFLICT (version) DO NOTHING;
+
+ALTER TABLE competitions ADD COLUMN xp_policy_version INT NULL REFERENCES xp_policies(version);
+
+-- +goose Down
+ALTER TABLE competitions DROP COLUMN IF EXISTS xp_policy_version;
+DROP TABLE IF EXISTS xp_policies;
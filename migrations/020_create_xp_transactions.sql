@@ -0,0 +1,20 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS xp_transactions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    amount INT NOT NULL,
+    reason TEXT NOT NULL,
+    competition_id UUID NULL REFERENCES competitions(id) ON DELETE SET NULL,
+    season_id UUID NULL REFERENCES seasons(id) ON DELETE SET NULL,
+    achievement_id TEXT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_xp_transactions_user ON xp_transactions(user_id, created_at);
+
+-- XP granted before the ledger existed becomes one opening entry per user,
+-- so recomputing from the ledger keeps existing balances
+INSERT INTO xp_transactions (user_id, amount, reason)
+SELECT id, xp, 'opening_balance' FROM users WHERE xp <> 0;
+
+-- +goose Down
+DROP TABLE IF EXISTS xp_transactions;
@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS level_ups (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    level INT NOT NULL,
+    level_name TEXT NOT NULL,
+    xp INT NOT NULL,
+    policy_version INT NOT NULL REFERENCES xp_policies(version),
+    reward_streak_freezes INT NOT NULL DEFAULT 0,
+    reward_flair TEXT NULL,
+    reward_public_competitions BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (user_id, level)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS level_ups;
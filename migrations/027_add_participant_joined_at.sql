@@ -0,0 +1,14 @@
+-- +goose Up
+ALTER TABLE participants ADD COLUMN IF NOT EXISTS joined_at TIMESTAMP;
+
+-- existing members count from the start, as they did before
+UPDATE participants p
+SET joined_at = c.start_date
+FROM competitions c
+WHERE c.id = p.competition_id AND p.joined_at IS NULL;
+
+ALTER TABLE participants ALTER COLUMN joined_at SET DEFAULT NOW();
+ALTER TABLE participants ALTER COLUMN joined_at SET NOT NULL;
+
+-- +goose Down
+ALTER TABLE participants DROP COLUMN IF EXISTS joined_at;
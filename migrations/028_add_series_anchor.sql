@@ -0,0 +1,10 @@
+-- +goose Up
+ALTER TABLE competition_series ADD COLUMN IF NOT EXISTS anchor TIMESTAMP;
+
+-- instances are counted from here on; the day of the next start is kept
+UPDATE competition_series SET anchor = next_start WHERE anchor IS NULL;
+
+ALTER TABLE competition_series ALTER COLUMN anchor SET NOT NULL;
+
+-- +goose Down
+ALTER TABLE competition_series DROP COLUMN IF EXISTS anchor;
@@ -0,0 +1,20 @@
+-- +goose Up
+-- the amounts seasons paid before they became part of the policy
+ALTER TABLE xp_policies ADD COLUMN IF NOT EXISTS season_first_xp INT NOT NULL DEFAULT 500;
+ALTER TABLE xp_policies ADD COLUMN IF NOT EXISTS season_second_xp INT NOT NULL DEFAULT 300;
+ALTER TABLE xp_policies ADD COLUMN IF NOT EXISTS season_third_xp INT NOT NULL DEFAULT 200;
+ALTER TABLE xp_policies ADD COLUMN IF NOT EXISTS season_top_half_xp INT NOT NULL DEFAULT 100;
+ALTER TABLE xp_policies ADD COLUMN IF NOT EXISTS season_bottom_half_xp INT NOT NULL DEFAULT 30;
+ALTER TABLE xp_policies ADD COLUMN IF NOT EXISTS season_win_xp INT NOT NULL DEFAULT 25;
+
+ALTER TABLE seasons ADD COLUMN IF NOT EXISTS xp_policy_version INT NULL REFERENCES xp_policies(version);
+
+-- +goose Down
+ALTER TABLE seasons DROP COLUMN IF EXISTS xp_policy_version;
+
+ALTER TABLE xp_policies DROP COLUMN IF EXISTS season_win_xp;
+ALTER TABLE xp_policies DROP COLUMN IF EXISTS season_bottom_half_xp;
+ALTER TABLE xp_policies DROP COLUMN IF EXISTS season_top_half_xp;
+ALTER TABLE xp_policies DROP COLUMN IF EXISTS season_third_xp;
+ALTER TABLE xp_policies DROP COLUMN IF EXISTS season_second_xp;
+ALTER TABLE xp_policies DROP COLUMN IF EXISTS season_first_xp;
@@ -0,0 +1,8 @@
+-- +goose Up
+-- links are keyed by the sender's Telegram user id; group chats have negative
+-- ids and could not be linked to one person, so drop them
+DELETE FROM telegram_links WHERE chat_id < 0;
+ALTER TABLE telegram_links RENAME COLUMN chat_id TO telegram_id;
+
+-- +goose Down
+ALTER TABLE telegram_links RENAME COLUMN telegram_id TO chat_id;
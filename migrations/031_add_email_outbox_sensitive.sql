@@ -0,0 +1,6 @@
+-- +goose Up
+-- bodies of sensitive messages (password resets) are wiped once they are done
+ALTER TABLE email_outbox ADD COLUMN IF NOT EXISTS sensitive BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose Down
+ALTER TABLE email_outbox DROP COLUMN IF EXISTS sensitive;
@@ -0,0 +1,9 @@
+-- +goose Up
+-- the streak run a freeze milestone was last paid for, so rebuilding the
+-- streak after an edit never pays the same milestone twice
+ALTER TABLE users ADD COLUMN IF NOT EXISTS streak_start_date DATE NULL;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS streak_freeze_milestone INT NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE users DROP COLUMN IF EXISTS streak_freeze_milestone;
+ALTER TABLE users DROP COLUMN IF EXISTS streak_start_date;